@@ -0,0 +1,50 @@
+// Package auth verifies the bearer tokens presented to the service and
+// resolves the user they were issued to.
+package auth
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	// ErrInvalidToken is returned when a token is malformed, expired or
+	// carries a signature that does not verify.
+	ErrInvalidToken = errors.New("invalid token")
+	// ErrUnverifiable is returned when a token cannot be checked locally,
+	// e.g. it is signed by a key or algorithm that is not configured. Such
+	// tokens may still be accepted by the auth service.
+	ErrUnverifiable = errors.New("token cannot be verified locally")
+	// ErrUnavailable is returned when the auth service cannot be reached.
+	ErrUnavailable = errors.New("auth service unavailable")
+)
+
+// Authenticator resolves the user a bearer token was issued to.
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (User, error)
+}
+
+// Fallback returns an Authenticator trying primary first and asking
+// secondary for the tokens primary cannot verify.
+func Fallback(primary, secondary Authenticator) Authenticator {
+	return fallback{primary: primary, secondary: secondary}
+}
+
+type fallback struct {
+	primary, secondary Authenticator
+}
+
+func (f fallback) Authenticate(ctx context.Context, token string) (User, error) {
+	user, err := f.primary.Authenticate(ctx, token)
+	if errors.Is(err, ErrUnverifiable) {
+		return f.secondary.Authenticate(ctx, token)
+	}
+	return user, err
+}
+
+// User is the user a token was issued to.
+type User struct {
+	Username string `json:"username"`
+	ID       int    `json:"id"`
+	Role     string `json:"role"`
+}
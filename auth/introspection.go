@@ -0,0 +1,225 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultIntrospectionTimeout = 3 * time.Second
+	DefaultCacheTTL             = time.Minute
+	DefaultCacheSize            = 10000
+	DefaultFailureThreshold     = 5
+	DefaultCooldown             = 30 * time.Second
+)
+
+// IntrospectionConfig configures an Introspector. Zero values select the
+// defaults above.
+type IntrospectionConfig struct {
+	// URL is the auth service endpoint returning the user of the bearer
+	// token sent to it.
+	URL     string
+	Timeout time.Duration
+	// CacheTTL bounds how long a successful lookup is reused. Failed
+	// lookups are never cached.
+	CacheTTL  time.Duration
+	CacheSize int
+	// FailureThreshold consecutive failures open the circuit, failing
+	// lookups fast for Cooldown before the service is tried again.
+	FailureThreshold int
+	Cooldown         time.Duration
+	HTTPClient       *http.Client
+}
+
+// Introspector authenticates tokens by asking the auth service.
+type Introspector struct {
+	url      string
+	client   *http.Client
+	cacheTTL time.Duration
+
+	cache   *tokenCache
+	breaker *breaker
+}
+
+// NewIntrospector returns an Introspector for cfg.
+func NewIntrospector(cfg IntrospectionConfig) (*Introspector, error) {
+	if cfg.URL == "" {
+		return nil, errors.New("introspection needs the auth service url")
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultIntrospectionTimeout
+	}
+	if cfg.CacheTTL <= 0 {
+		cfg.CacheTTL = DefaultCacheTTL
+	}
+	if cfg.CacheSize <= 0 {
+		cfg.CacheSize = DefaultCacheSize
+	}
+	if cfg.FailureThreshold <= 0 {
+		cfg.FailureThreshold = DefaultFailureThreshold
+	}
+	if cfg.Cooldown <= 0 {
+		cfg.Cooldown = DefaultCooldown
+	}
+	client := &http.Client{}
+	if cfg.HTTPClient != nil {
+		*client = *cfg.HTTPClient
+	}
+	client.Timeout = cfg.Timeout
+
+	return &Introspector{
+		url:      cfg.URL,
+		client:   client,
+		cacheTTL: cfg.CacheTTL,
+		cache:    &tokenCache{size: cfg.CacheSize, entries: make(map[[sha256.Size]byte]cacheEntry)},
+		breaker:  &breaker{threshold: cfg.FailureThreshold, cooldown: cfg.Cooldown},
+	}, nil
+}
+
+// Authenticate returns the user of token. It fails with ErrInvalidToken when
+// the auth service rejects the token and with ErrUnavailable when the service
+// cannot be reached or the circuit is open.
+func (i *Introspector) Authenticate(ctx context.Context, token string) (User, error) {
+	key := sha256.Sum256([]byte(token))
+	if user, ok := i.cache.get(key); ok {
+		return user, nil
+	}
+
+	if !i.breaker.allow() {
+		return User{}, fmt.Errorf("%w: circuit open", ErrUnavailable)
+	}
+	user, err := i.introspect(ctx, token)
+	i.breaker.record(err == nil || errors.Is(err, ErrInvalidToken))
+	if err != nil {
+		return User{}, err
+	}
+
+	i.cache.put(key, user, i.cacheTTL)
+	return user, nil
+}
+
+func (i *Introspector) introspect(ctx context.Context, token string) (User, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, i.url, nil)
+	if err != nil {
+		return User{}, err
+	}
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Authorization", "Bearer "+token)
+
+	res, err := i.client.Do(req)
+	if err != nil {
+		return User{}, fmt.Errorf("%w: %v", ErrUnavailable, err)
+	}
+	defer res.Body.Close()
+
+	switch {
+	case res.StatusCode == http.StatusOK:
+	case res.StatusCode >= http.StatusInternalServerError:
+		return User{}, fmt.Errorf("%w: status %d", ErrUnavailable, res.StatusCode)
+	default:
+		return User{}, fmt.Errorf("%w: auth service responded %d", ErrInvalidToken, res.StatusCode)
+	}
+
+	var user User
+	if err := json.NewDecoder(res.Body).Decode(&user); err != nil {
+		return User{}, fmt.Errorf("%w: decoding user: %v", ErrUnavailable, err)
+	}
+	return user, nil
+}
+
+type cacheEntry struct {
+	user      User
+	expiresAt time.Time
+}
+
+// tokenCache holds successful lookups keyed by the token hash, so the tokens
+// themselves are not kept in memory.
+type tokenCache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[[sha256.Size]byte]cacheEntry
+}
+
+func (c *tokenCache) get(key [sha256.Size]byte) (User, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		return User{}, false
+	}
+	if time.Now().After(e.expiresAt) {
+		delete(c.entries, key)
+		return User{}, false
+	}
+	return e.user, true
+}
+
+func (c *tokenCache) put(key [sha256.Size]byte, user User, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if len(c.entries) >= c.size {
+		for k, e := range c.entries {
+			if now.After(e.expiresAt) {
+				delete(c.entries, k)
+			}
+		}
+	}
+	// Still full of live entries: evict arbitrary ones rather than grow.
+	for k := range c.entries {
+		if len(c.entries) < c.size {
+			break
+		}
+		delete(c.entries, k)
+	}
+	c.entries[key] = cacheEntry{user: user, expiresAt: now.Add(ttl)}
+}
+
+// breaker is a circuit breaker opening after threshold consecutive failures.
+// Once the cooldown has passed a single trial request is let through; its
+// outcome closes the circuit or opens it for another cooldown.
+type breaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	failures  int
+	openUntil time.Time
+	trial     bool
+}
+
+func (b *breaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures < b.threshold {
+		return true
+	}
+	if time.Now().Before(b.openUntil) || b.trial {
+		return false
+	}
+	b.trial = true
+	return true
+}
+
+func (b *breaker) record(ok bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.trial = false
+	if ok {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.failures >= b.threshold {
+		b.openUntil = time.Now().Add(b.cooldown)
+	}
+}
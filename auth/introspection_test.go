@@ -0,0 +1,170 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var testUser = User{Username: "alice", ID: 7, Role: "admin"}
+
+// authService is a fake auth service counting the lookups it answers. Its
+// behaviour can be switched between calls.
+type authService struct {
+	*httptest.Server
+	calls  atomic.Int32
+	status atomic.Int32
+	delay  atomic.Int64
+}
+
+func newAuthService(t *testing.T) *authService {
+	t.Helper()
+	s := &authService{}
+	s.status.Store(http.StatusOK)
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.calls.Add(1)
+		if d := time.Duration(s.delay.Load()); d > 0 {
+			select {
+			case <-time.After(d):
+			case <-r.Context().Done():
+				return
+			}
+		}
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		status := int(s.status.Load())
+		w.WriteHeader(status)
+		if status == http.StatusOK {
+			json.NewEncoder(w).Encode(testUser)
+		}
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func newTestIntrospector(t *testing.T, cfg IntrospectionConfig) *Introspector {
+	t.Helper()
+	i, err := NewIntrospector(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return i
+}
+
+func TestIntrospectorCachesWithinTTL(t *testing.T) {
+	srv := newAuthService(t)
+	i := newTestIntrospector(t, IntrospectionConfig{URL: srv.URL, CacheTTL: time.Minute})
+	ctx := context.Background()
+
+	for n := 0; n < 3; n++ {
+		user, err := i.Authenticate(ctx, "token")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if user != testUser {
+			t.Errorf("Authenticate = %+v, want %+v", user, testUser)
+		}
+	}
+	if calls := srv.calls.Load(); calls != 1 {
+		t.Errorf("auth service called %d times, want 1", calls)
+	}
+}
+
+func TestIntrospectorRefreshesAfterTTL(t *testing.T) {
+	srv := newAuthService(t)
+	const ttl = 50 * time.Millisecond
+	i := newTestIntrospector(t, IntrospectionConfig{URL: srv.URL, CacheTTL: ttl})
+	ctx := context.Background()
+
+	if _, err := i.Authenticate(ctx, "token"); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(2 * ttl)
+	if _, err := i.Authenticate(ctx, "token"); err != nil {
+		t.Fatal(err)
+	}
+	if calls := srv.calls.Load(); calls != 2 {
+		t.Errorf("auth service called %d times, want 2", calls)
+	}
+}
+
+func TestIntrospectorDoesNotCacheRejections(t *testing.T) {
+	srv := newAuthService(t)
+	i := newTestIntrospector(t, IntrospectionConfig{URL: srv.URL})
+	ctx := context.Background()
+
+	for n := 0; n < 2; n++ {
+		if _, err := i.Authenticate(ctx, "other"); !errors.Is(err, ErrInvalidToken) {
+			t.Errorf("Authenticate: %v, want %v", err, ErrInvalidToken)
+		}
+	}
+	if calls := srv.calls.Load(); calls != 2 {
+		t.Errorf("auth service called %d times, want 2", calls)
+	}
+}
+
+func TestIntrospectorTimeout(t *testing.T) {
+	srv := newAuthService(t)
+	srv.delay.Store(int64(time.Second))
+	const timeout = 50 * time.Millisecond
+	i := newTestIntrospector(t, IntrospectionConfig{URL: srv.URL, Timeout: timeout})
+
+	start := time.Now()
+	_, err := i.Authenticate(context.Background(), "token")
+	if !errors.Is(err, ErrUnavailable) {
+		t.Errorf("Authenticate: %v, want %v", err, ErrUnavailable)
+	}
+	if elapsed := time.Since(start); elapsed >= time.Second {
+		t.Errorf("Authenticate took %v, want it to give up after %v", elapsed, timeout)
+	}
+}
+
+func TestIntrospectorBreaker(t *testing.T) {
+	srv := newAuthService(t)
+	srv.status.Store(http.StatusServiceUnavailable)
+	const threshold, cooldown = 3, 100 * time.Millisecond
+	i := newTestIntrospector(t, IntrospectionConfig{
+		URL:              srv.URL,
+		FailureThreshold: threshold,
+		Cooldown:         cooldown,
+	})
+	ctx := context.Background()
+
+	// Consecutive failures open the circuit.
+	for n := 0; n < threshold; n++ {
+		if _, err := i.Authenticate(ctx, "token"); !errors.Is(err, ErrUnavailable) {
+			t.Fatalf("Authenticate: %v, want %v", err, ErrUnavailable)
+		}
+	}
+	if calls := srv.calls.Load(); calls != threshold {
+		t.Fatalf("auth service called %d times, want %d", calls, threshold)
+	}
+
+	// While open, lookups fail without reaching the service.
+	if _, err := i.Authenticate(ctx, "token"); !errors.Is(err, ErrUnavailable) {
+		t.Errorf("Authenticate with open circuit: %v, want %v", err, ErrUnavailable)
+	}
+	if calls := srv.calls.Load(); calls != threshold {
+		t.Errorf("auth service called %d times with open circuit, want %d", calls, threshold)
+	}
+
+	// After the cooldown a successful trial closes the circuit again.
+	srv.status.Store(http.StatusOK)
+	time.Sleep(2 * cooldown)
+	if _, err := i.Authenticate(ctx, "token"); err != nil {
+		t.Fatalf("trial Authenticate: %v", err)
+	}
+	if _, err := i.Authenticate(ctx, "other"); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("Authenticate after trial: %v, want %v", err, ErrInvalidToken)
+	}
+	if calls := srv.calls.Load(); calls != threshold+2 {
+		t.Errorf("auth service called %d times, want %d", calls, threshold+2)
+	}
+}
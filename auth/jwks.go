@@ -0,0 +1,216 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// minRefetchInterval bounds how often an unknown key ID triggers a refetch of
+// the key set, so that tokens with made-up key IDs cannot hammer the issuer.
+const minRefetchInterval = 30 * time.Second
+
+var errUnknownKey = errors.New("unknown signing key")
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// keySet is a JSON Web Key Set loaded from a URL or a file. The keys are
+// cached and reloaded every refresh interval, and early when a token names a
+// key that is not in the cache, which picks up rotated keys.
+type keySet struct {
+	url     string
+	file    string
+	refresh time.Duration
+	client  *http.Client
+
+	mu        sync.Mutex
+	keys      map[string]crypto.PublicKey
+	loadedAt  time.Time
+	fileMtime time.Time
+}
+
+func (s *keySet) key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.keys == nil || time.Since(s.loadedAt) > s.refresh || s.fileChanged() {
+		if err := s.load(ctx); err != nil && s.keys == nil {
+			return nil, err
+		}
+	}
+	if k, ok := s.lookup(kid); ok {
+		return k, nil
+	}
+	if time.Since(s.loadedAt) < minRefetchInterval {
+		return nil, errUnknownKey
+	}
+	if err := s.load(ctx); err != nil {
+		return nil, err
+	}
+	if k, ok := s.lookup(kid); ok {
+		return k, nil
+	}
+	return nil, errUnknownKey
+}
+
+// lookup finds the key with the given ID. Tokens without a key ID are
+// accepted only when the set holds a single key.
+func (s *keySet) lookup(kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(s.keys) == 1 {
+		for _, k := range s.keys {
+			return k, true
+		}
+	}
+	k, ok := s.keys[kid]
+	return k, ok
+}
+
+func (s *keySet) fileChanged() bool {
+	if s.file == "" {
+		return false
+	}
+	info, err := os.Stat(s.file)
+	return err == nil && !info.ModTime().Equal(s.fileMtime)
+}
+
+// load replaces the cached keys. The previous keys are kept when loading
+// fails, so a temporarily unavailable issuer does not reject every token.
+func (s *keySet) load(ctx context.Context) error {
+	s.loadedAt = time.Now()
+
+	var data []byte
+	if s.file != "" {
+		info, err := os.Stat(s.file)
+		if err != nil {
+			return fmt.Errorf("reading key set: %w", err)
+		}
+		if data, err = os.ReadFile(s.file); err != nil {
+			return fmt.Errorf("reading key set: %w", err)
+		}
+		s.fileMtime = info.ModTime()
+	} else {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+		if err != nil {
+			return fmt.Errorf("fetching key set: %w", err)
+		}
+		res, err := s.client.Do(req)
+		if err != nil {
+			return fmt.Errorf("fetching key set: %w", err)
+		}
+		defer res.Body.Close()
+		if res.StatusCode != http.StatusOK {
+			return fmt.Errorf("fetching key set: unexpected status %d", res.StatusCode)
+		}
+		if data, err = io.ReadAll(io.LimitReader(res.Body, 1<<20)); err != nil {
+			return fmt.Errorf("fetching key set: %w", err)
+		}
+	}
+
+	keys, err := parseKeySet(data)
+	if err != nil {
+		return err
+	}
+	s.keys = keys
+	return nil
+}
+
+func parseKeySet(data []byte) (map[string]crypto.PublicKey, error) {
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("parsing key set: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("parsing key %q: %w", k.Kid, err)
+		}
+		if key != nil {
+			keys[k.Kid] = key
+		}
+	}
+	return keys, nil
+}
+
+// publicKey decodes the key. Key types other than RSA and EC are skipped.
+func (k jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, errors.New("invalid exponent")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("point is not on curve")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	}
+	return nil, nil
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, errors.New("empty value")
+	}
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,188 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// DefaultJWKSRefresh is how long a fetched key set is used before it is
+// fetched again.
+const DefaultJWKSRefresh = time.Hour
+
+// JWTConfig configures local verification of JWTs.
+type JWTConfig struct {
+	// Secret verifies HS256 tokens. HMAC tokens are rejected when it is
+	// empty.
+	Secret []byte
+	// JWKSURL or JWKSFile point to the key set verifying RS256 and ES256
+	// tokens. The file takes precedence when both are set.
+	JWKSURL  string
+	JWKSFile string
+	// JWKSRefresh is how often the key set is reloaded, DefaultJWKSRefresh
+	// when zero.
+	JWKSRefresh time.Duration
+	// Issuer and Audience, when set, must match the iss and aud claims.
+	Issuer   string
+	Audience string
+	// Leeway tolerates clock skew when checking exp, nbf and iat.
+	Leeway time.Duration
+	// HTTPClient fetches the key set, http.DefaultClient when nil.
+	HTTPClient *http.Client
+}
+
+// JWTVerifier verifies JWTs without calling the auth service.
+type JWTVerifier struct {
+	secret   []byte
+	keys     *keySet
+	issuer   string
+	audience string
+	leeway   time.Duration
+}
+
+// NewJWTVerifier returns a verifier for the configured secret and key set.
+func NewJWTVerifier(cfg JWTConfig) (*JWTVerifier, error) {
+	v := &JWTVerifier{
+		secret:   cfg.Secret,
+		issuer:   cfg.Issuer,
+		audience: cfg.Audience,
+		leeway:   cfg.Leeway,
+	}
+	if cfg.JWKSURL != "" || cfg.JWKSFile != "" {
+		refresh := cfg.JWKSRefresh
+		if refresh <= 0 {
+			refresh = DefaultJWKSRefresh
+		}
+		client := cfg.HTTPClient
+		if client == nil {
+			client = http.DefaultClient
+		}
+		v.keys = &keySet{url: cfg.JWKSURL, file: cfg.JWKSFile, refresh: refresh, client: client}
+	}
+	if len(v.secret) == 0 && v.keys == nil {
+		return nil, errors.New("jwt verification needs a secret or a key set")
+	}
+	return v, nil
+}
+
+// claims are the registered claims plus the user claims issued by the auth
+// service.
+type claims struct {
+	jwt.RegisteredClaims
+	Username string      `json:"username"`
+	ID       interface{} `json:"id"`
+	Role     string      `json:"role"`
+}
+
+// Authenticate checks the signature and validity of token and returns the user it
+// was issued to. Tokens that are not JWTs, or are signed with an algorithm or
+// key that is not configured, yield ErrUnverifiable; all other failures yield
+// ErrInvalidToken.
+func (v *JWTVerifier) Authenticate(ctx context.Context, token string) (User, error) {
+	var c claims
+	parser := jwt.NewParser(jwt.WithoutClaimsValidation())
+	_, err := parser.ParseWithClaims(token, &c, func(t *jwt.Token) (interface{}, error) {
+		return v.key(ctx, t)
+	})
+	if err != nil {
+		var verr *jwt.ValidationError
+		if errors.As(err, &verr) {
+			if errors.Is(verr.Inner, ErrUnverifiable) {
+				return User{}, verr.Inner
+			}
+			if verr.Errors&jwt.ValidationErrorMalformed != 0 {
+				return User{}, fmt.Errorf("%w: %v", ErrUnverifiable, err)
+			}
+		}
+		return User{}, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	if err := v.validate(&c); err != nil {
+		return User{}, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	id, err := userID(c.ID, c.Subject)
+	if err != nil {
+		return User{}, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	return User{Username: c.Username, ID: id, Role: c.Role}, nil
+}
+
+// key selects the verification key for the token's algorithm. Each algorithm
+// family only accepts its own key type, so a public key can never be used as
+// an HMAC secret.
+func (v *JWTVerifier) key(ctx context.Context, t *jwt.Token) (interface{}, error) {
+	switch t.Method.Alg() {
+	case jwt.SigningMethodHS256.Alg():
+		if len(v.secret) == 0 {
+			return nil, ErrUnverifiable
+		}
+		return v.secret, nil
+	case jwt.SigningMethodRS256.Alg(), jwt.SigningMethodES256.Alg():
+		if v.keys == nil {
+			return nil, ErrUnverifiable
+		}
+		kid, _ := t.Header["kid"].(string)
+		key, err := v.keys.key(ctx, kid)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrUnverifiable, err)
+		}
+		switch key.(type) {
+		case *rsa.PublicKey:
+			if t.Method.Alg() == jwt.SigningMethodRS256.Alg() {
+				return key, nil
+			}
+		case *ecdsa.PublicKey:
+			if t.Method.Alg() == jwt.SigningMethodES256.Alg() {
+				return key, nil
+			}
+		}
+		return nil, fmt.Errorf("key %q does not match algorithm %s", kid, t.Method.Alg())
+	}
+	return nil, ErrUnverifiable
+}
+
+func (v *JWTVerifier) validate(c *claims) error {
+	now := time.Now()
+	if c.ExpiresAt == nil {
+		return errors.New("token has no expiry")
+	}
+	if now.After(c.ExpiresAt.Add(v.leeway)) {
+		return errors.New("token is expired")
+	}
+	if c.NotBefore != nil && now.Add(v.leeway).Before(c.NotBefore.Time) {
+		return errors.New("token is not valid yet")
+	}
+	if c.IssuedAt != nil && now.Add(v.leeway).Before(c.IssuedAt.Time) {
+		return errors.New("token is issued in the future")
+	}
+	if v.issuer != "" && c.Issuer != v.issuer {
+		return fmt.Errorf("unexpected issuer %q", c.Issuer)
+	}
+	if v.audience != "" && !c.VerifyAudience(v.audience, true) {
+		return errors.New("unexpected audience")
+	}
+	return nil
+}
+
+// userID reads the user ID from the id claim, falling back to sub.
+func userID(id interface{}, sub string) (int, error) {
+	switch id := id.(type) {
+	case float64:
+		return int(id), nil
+	case string:
+		return strconv.Atoi(id)
+	case nil:
+		if sub == "" {
+			return 0, errors.New("token has no user id")
+		}
+		return strconv.Atoi(sub)
+	}
+	return 0, fmt.Errorf("invalid user id %v", id)
+}
@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Permission is an action a role may be allowed to perform.
+type Permission string
+
+const (
+	ProductCreate Permission = "product:create"
+	ProductUpdate Permission = "product:update"
+	ProductDelete Permission = "product:delete"
+	StockRead     Permission = "stock:read"
+	StockAdjust   Permission = "stock:adjust"
+	StockIncrease Permission = "stock:increase"
+	StockDecrease Permission = "stock:decrease"
+	StockReserve  Permission = "stock:reserve"
+)
+
+// Roles known to the default policy.
+const (
+	RoleAdmin            = "admin"
+	RoleCatalogEditor    = "catalog-editor"
+	RoleInventoryManager = "inventory-manager"
+	RoleViewer           = "viewer"
+)
+
+// Policy maps roles to the permissions they are granted. A grant of "*"
+// allows everything and a grant ending in ":*" allows every permission with
+// that prefix, e.g. "stock:*".
+type Policy struct {
+	roles map[string][]string
+}
+
+// DefaultPolicy returns the policy used when no policy file is configured.
+func DefaultPolicy() *Policy {
+	return &Policy{roles: map[string][]string{
+		RoleAdmin:            {"*"},
+		RoleCatalogEditor:    {string(ProductCreate), string(ProductUpdate), string(ProductDelete), string(StockRead)},
+		RoleInventoryManager: {"stock:*"},
+		RoleViewer:           {string(StockRead)},
+	}}
+}
+
+// LoadPolicy reads a policy file of the form
+//
+//	{"roles": {"admin": ["*"], "viewer": ["stock:read"]}}
+func LoadPolicy(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading policy: %w", err)
+	}
+	var file struct {
+		Roles map[string][]string `json:"roles"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parsing policy: %w", err)
+	}
+	if len(file.Roles) == 0 {
+		return nil, fmt.Errorf("policy %s defines no roles", path)
+	}
+	for role, grants := range file.Roles {
+		for _, g := range grants {
+			if g != "*" && !strings.Contains(g, ":") {
+				return nil, fmt.Errorf("role %q: invalid permission %q", role, g)
+			}
+		}
+	}
+	return &Policy{roles: file.Roles}, nil
+}
+
+// Allowed reports whether role is granted perm. Unknown roles are granted
+// nothing.
+func (p *Policy) Allowed(role string, perm Permission) bool {
+	for _, g := range p.roles[role] {
+		if g == "*" || g == string(perm) {
+			return true
+		}
+		if prefix := strings.TrimSuffix(g, "*"); prefix != g && strings.HasPrefix(string(perm), prefix) {
+			return true
+		}
+	}
+	return false
+}
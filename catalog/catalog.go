@@ -0,0 +1,324 @@
+// Package catalog implements the product queries shared by the HTTP and gRPC servers.
+package catalog
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/law-a-1/product-service/ent"
+	"github.com/law-a-1/product-service/ent/predicate"
+	"github.com/law-a-1/product-service/ent/product"
+	"github.com/law-a-1/product-service/ent/reservation"
+	"github.com/law-a-1/product-service/stock"
+)
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+var (
+	ErrNotFound      = errors.New("product with given ID not found")
+	ErrInvalidCursor = errors.New("invalid cursor")
+	ErrInvalidSort   = errors.New("invalid sort field")
+)
+
+// Item is a product together with the stock available for sale, that is the
+// stock not held by active reservations. Its media gallery is loaded in
+// display order.
+type Item struct {
+	*ent.Product
+	Available int
+}
+
+// Get returns the product with the given ID.
+func Get(ctx context.Context, client *ent.Client, id int) (Item, error) {
+	p, err := client.Product.
+		Query().
+		Where(product.ID(id)).
+		WithMedia(galleryOrder).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return Item{}, ErrNotFound
+		}
+		return Item{}, err
+	}
+	items, err := withAvailable(ctx, client, []*ent.Product{p})
+	if err != nil {
+		return Item{}, err
+	}
+	return items[0], nil
+}
+
+// GetMany returns the products with the given IDs ordered by ID. Unknown IDs
+// are skipped.
+func GetMany(ctx context.Context, client *ent.Client, ids ...int) ([]Item, error) {
+	products, err := client.Product.
+		Query().
+		Where(product.IDIn(ids...)).
+		WithMedia(galleryOrder).
+		Order(ent.Asc(product.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return withAvailable(ctx, client, products)
+}
+
+// Fields products can be sorted by.
+const (
+	SortID        = product.FieldID
+	SortPrice     = product.FieldPrice
+	SortName      = product.FieldName
+	SortCreatedAt = product.FieldCreatedAt
+	SortUpdatedAt = product.FieldUpdatedAt
+)
+
+// ListParams selects and orders a page of products.
+type ListParams struct {
+	// Limit is the page size, zero lists every product.
+	Limit int
+	// Cursor is the NextCursor of the previous page.
+	Cursor string
+	// Sort is one of the Sort fields, prefixed with "-" for descending order.
+	// Products are sorted by ID when empty. Ties are always broken by ID so
+	// the order is stable across pages.
+	Sort string
+
+	MinPrice *int
+	MaxPrice *int
+	// InStock keeps the products with stock that is not reserved.
+	InStock    bool
+	NamePrefix string
+}
+
+// Page is a page of products.
+type Page struct {
+	Items []Item
+	// Total is the number of products matching the filters across all pages.
+	Total int
+	// NextCursor selects the next page, it is empty on the last page.
+	NextCursor string
+}
+
+// List returns a page of the products matching the filters of params.
+func List(ctx context.Context, client *ent.Client, params ListParams) (Page, error) {
+	if params.Limit > MaxPageSize {
+		params.Limit = MaxPageSize
+	}
+	field, desc, err := parseSort(params.Sort)
+	if err != nil {
+		return Page{}, err
+	}
+
+	query := client.Product.Query().Where(filters(params)...)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return Page{}, err
+	}
+
+	if params.Cursor != "" {
+		c, err := decodeCursor(params.Cursor)
+		if err != nil {
+			return Page{}, err
+		}
+		if c.Sort != params.Sort {
+			return Page{}, ErrInvalidCursor
+		}
+		after, err := c.after(field, desc)
+		if err != nil {
+			return Page{}, err
+		}
+		query.Where(after)
+	}
+	order := ent.Asc
+	if desc {
+		order = ent.Desc
+	}
+	if field == product.FieldID {
+		query.Order(order(product.FieldID))
+	} else {
+		query.Order(order(field), order(product.FieldID))
+	}
+	if params.Limit > 0 {
+		// Fetch one extra product to learn whether there is a next page.
+		query.Limit(params.Limit + 1)
+	}
+
+	products, err := query.WithMedia(galleryOrder).All(ctx)
+	if err != nil {
+		return Page{}, err
+	}
+
+	var page Page
+	page.Total = total
+	if params.Limit > 0 && len(products) > params.Limit {
+		products = products[:params.Limit]
+		page.NextCursor = newCursor(params.Sort, field, products[len(products)-1]).encode()
+	}
+	page.Items, err = withAvailable(ctx, client, products)
+	if err != nil {
+		return Page{}, err
+	}
+	return page, nil
+}
+
+func filters(params ListParams) []predicate.Product {
+	var ps []predicate.Product
+	if params.MinPrice != nil {
+		ps = append(ps, product.PriceGTE(*params.MinPrice))
+	}
+	if params.MaxPrice != nil {
+		ps = append(ps, product.PriceLTE(*params.MaxPrice))
+	}
+	if params.InStock {
+		ps = append(ps, available())
+	}
+	if params.NamePrefix != "" {
+		ps = append(ps, product.NameHasPrefix(params.NamePrefix))
+	}
+	return ps
+}
+
+// available selects the products with stock left over after their active
+// reservations, matching Item.Available.
+func available() predicate.Product {
+	return func(s *sql.Selector) {
+		r := sql.Table(reservation.Table)
+		reserved := sql.Select(sql.Sum(r.C(reservation.FieldQuantity))).
+			From(r).
+			Where(sql.And(
+				sql.ColumnsEQ(r.C(reservation.FieldProductID), s.C(product.FieldID)),
+				sql.EQ(r.C(reservation.FieldStatus), reservation.StatusActive),
+				sql.GT(r.C(reservation.FieldExpiresAt), time.Now()),
+			))
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.Ident(s.C(product.FieldStock)).WriteOp(sql.OpGT).
+				WriteString("COALESCE((").Join(reserved).WriteString("), 0)")
+		}))
+	}
+}
+
+func parseSort(sort string) (string, bool, error) {
+	field := strings.TrimPrefix(sort, "-")
+	desc := field != sort
+	switch field {
+	case "":
+		return SortID, desc, nil
+	case SortID, SortPrice, SortName, SortCreatedAt, SortUpdatedAt:
+		return field, desc, nil
+	}
+	return "", false, ErrInvalidSort
+}
+
+func withAvailable(ctx context.Context, client *ent.Client, products []*ent.Product) ([]Item, error) {
+	ids := make([]int, 0, len(products))
+	for _, p := range products {
+		ids = append(ids, p.ID)
+	}
+	reserved, err := stock.Reserved(ctx, client.Reservation, ids...)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]Item, 0, len(products))
+	for _, p := range products {
+		items = append(items, Item{Product: p, Available: p.Stock - reserved[p.ID]})
+	}
+	return items, nil
+}
+
+// cursor is the position of the last product of a page in the sort order.
+type cursor struct {
+	Sort  string `json:"s,omitempty"`
+	Value string `json:"v,omitempty"`
+	ID    int    `json:"id"`
+}
+
+func newCursor(sort, field string, p *ent.Product) cursor {
+	c := cursor{Sort: sort, ID: p.ID}
+	switch field {
+	case SortPrice:
+		c.Value = strconv.Itoa(p.Price)
+	case SortName:
+		c.Value = p.Name
+	case SortCreatedAt:
+		c.Value = p.CreatedAt.Format(time.RFC3339Nano)
+	case SortUpdatedAt:
+		c.Value = p.UpdatedAt.Format(time.RFC3339Nano)
+	}
+	return c
+}
+
+// after returns the predicate selecting the products that follow the cursor.
+func (c cursor) after(field string, desc bool) (predicate.Product, error) {
+	idAfter := product.IDGT(c.ID)
+	if desc {
+		idAfter = product.IDLT(c.ID)
+	}
+
+	var beyond, equal predicate.Product
+	switch field {
+	case SortID:
+		return idAfter, nil
+	case SortPrice:
+		v, err := strconv.Atoi(c.Value)
+		if err != nil {
+			return nil, ErrInvalidCursor
+		}
+		beyond, equal = product.PriceGT(v), product.PriceEQ(v)
+		if desc {
+			beyond = product.PriceLT(v)
+		}
+	case SortName:
+		beyond, equal = product.NameGT(c.Value), product.NameEQ(c.Value)
+		if desc {
+			beyond = product.NameLT(c.Value)
+		}
+	case SortCreatedAt:
+		v, err := time.Parse(time.RFC3339Nano, c.Value)
+		if err != nil {
+			return nil, ErrInvalidCursor
+		}
+		beyond, equal = product.CreatedAtGT(v), product.CreatedAtEQ(v)
+		if desc {
+			beyond = product.CreatedAtLT(v)
+		}
+	case SortUpdatedAt:
+		v, err := time.Parse(time.RFC3339Nano, c.Value)
+		if err != nil {
+			return nil, ErrInvalidCursor
+		}
+		beyond, equal = product.UpdatedAtGT(v), product.UpdatedAtEQ(v)
+		if desc {
+			beyond = product.UpdatedAtLT(v)
+		}
+	default:
+		return nil, ErrInvalidCursor
+	}
+	return product.Or(beyond, product.And(equal, idAfter)), nil
+}
+
+func (c cursor) encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(s string) (cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return cursor{}, ErrInvalidCursor
+	}
+	var c cursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return cursor{}, ErrInvalidCursor
+	}
+	return c, nil
+}
@@ -0,0 +1,250 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+
+	"github.com/law-a-1/product-service/ent"
+	"github.com/law-a-1/product-service/ent/product"
+	"github.com/law-a-1/product-service/ent/productmedia"
+	"github.com/law-a-1/product-service/ent/schema"
+	"github.com/law-a-1/product-service/stock"
+)
+
+var (
+	ErrMediaNotFound = errors.New("media with given ID not found")
+	ErrInvalidOrder  = errors.New("order must list every media of the product exactly once")
+)
+
+// NewMedia describes a stored file to add to the gallery of a product.
+type NewMedia struct {
+	Kind     productmedia.Kind
+	URL      string
+	Variants map[string]schema.ImageVariant
+	AltText  string
+	Primary  bool
+}
+
+// galleryOrder orders media the way galleries are displayed.
+func galleryOrder(q *ent.ProductMediaQuery) {
+	q.Order(ent.Asc(productmedia.FieldPosition), ent.Asc(productmedia.FieldID))
+}
+
+// Gallery returns the media of the product with the given ID in display order.
+func Gallery(ctx context.Context, client *ent.Client, productID int) ([]*ent.ProductMedia, error) {
+	q := client.ProductMedia.
+		Query().
+		Where(productmedia.ProductID(productID))
+	galleryOrder(q)
+	return q.All(ctx)
+}
+
+// AddMedia appends media to the end of the gallery of the product with the
+// given ID. The first media of a gallery always becomes its primary one.
+func AddMedia(ctx context.Context, client *ent.Client, productID int, m NewMedia) (*ent.ProductMedia, error) {
+	var created *ent.ProductMedia
+	err := stock.WithTx(ctx, client, func(tx *ent.Tx) error {
+		if err := lockGallery(ctx, tx, productID); err != nil {
+			return err
+		}
+
+		gallery, err := tx.ProductMedia.
+			Query().
+			Where(productmedia.ProductID(productID)).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		position := 0
+		for _, g := range gallery {
+			if g.Position >= position {
+				position = g.Position + 1
+			}
+		}
+
+		primary := m.Primary || len(gallery) == 0
+		if primary {
+			if err := clearPrimary(ctx, tx, productID); err != nil {
+				return err
+			}
+		}
+
+		c := tx.ProductMedia.
+			Create().
+			SetProductID(productID).
+			SetKind(m.Kind).
+			SetURL(m.URL).
+			SetAltText(m.AltText).
+			SetPosition(position).
+			SetIsPrimary(primary)
+		if len(m.Variants) > 0 {
+			c.SetVariants(m.Variants)
+		}
+		created, err = c.Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// ReorderMedia sets the display order of the gallery of the product with the
+// given ID to the order of ids, which must list every media of the gallery.
+func ReorderMedia(ctx context.Context, client *ent.Client, productID int, ids []int) error {
+	return stock.WithTx(ctx, client, func(tx *ent.Tx) error {
+		if err := lockGallery(ctx, tx, productID); err != nil {
+			return err
+		}
+		gallery, err := tx.ProductMedia.
+			Query().
+			Where(productmedia.ProductID(productID)).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		if len(ids) != len(gallery) {
+			return ErrInvalidOrder
+		}
+		existing := make(map[int]bool, len(gallery))
+		for _, g := range gallery {
+			existing[g.ID] = true
+		}
+		for _, id := range ids {
+			if !existing[id] {
+				return ErrInvalidOrder
+			}
+			// Each ID may only be listed once.
+			delete(existing, id)
+		}
+
+		for position, id := range ids {
+			err := tx.ProductMedia.
+				UpdateOneID(id).
+				SetPosition(position).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// MediaChange lists the attributes of a gallery media to change, nil fields
+// are left as they are.
+type MediaChange struct {
+	AltText *string
+	Primary *bool
+}
+
+// UpdateMedia changes a media of the gallery of the product with the given ID.
+// Making a media primary demotes the previous primary one. The primary media
+// cannot be demoted directly, another one has to be made primary instead.
+func UpdateMedia(ctx context.Context, client *ent.Client, productID, mediaID int, change MediaChange) (*ent.ProductMedia, error) {
+	var updated *ent.ProductMedia
+	err := stock.WithTx(ctx, client, func(tx *ent.Tx) error {
+		if err := lockGallery(ctx, tx, productID); err != nil {
+			return err
+		}
+		m, err := lockMedia(ctx, tx, productID, mediaID)
+		if err != nil {
+			return err
+		}
+
+		upd := m.Update()
+		if change.AltText != nil {
+			upd.SetAltText(*change.AltText)
+		}
+		if change.Primary != nil && *change.Primary && !m.IsPrimary {
+			if err := clearPrimary(ctx, tx, productID); err != nil {
+				return err
+			}
+			upd.SetIsPrimary(true)
+		}
+		updated, err = upd.Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// DeleteMedia removes a media from the gallery of the product with the given
+// ID and returns it, so its files can be removed. When the primary media is
+// deleted, the next one in display order becomes primary.
+func DeleteMedia(ctx context.Context, client *ent.Client, productID, mediaID int) (*ent.ProductMedia, error) {
+	var deleted *ent.ProductMedia
+	err := stock.WithTx(ctx, client, func(tx *ent.Tx) error {
+		if err := lockGallery(ctx, tx, productID); err != nil {
+			return err
+		}
+		m, err := lockMedia(ctx, tx, productID, mediaID)
+		if err != nil {
+			return err
+		}
+		if err := tx.ProductMedia.DeleteOne(m).Exec(ctx); err != nil {
+			return err
+		}
+		deleted = m
+		if !m.IsPrimary {
+			return nil
+		}
+
+		q := tx.ProductMedia.
+			Query().
+			Where(productmedia.ProductID(productID))
+		galleryOrder(q)
+		next, err := q.First(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		return next.Update().SetIsPrimary(true).Exec(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deleted, nil
+}
+
+// lockGallery locks the row of the product with the given ID, which
+// serialises the changes to its gallery: row locks on the media alone would
+// not keep a concurrent AddMedia from taking a position being reordered.
+func lockGallery(ctx context.Context, tx *ent.Tx, productID int) error {
+	_, err := tx.Product.
+		Query().
+		Where(product.ID(productID)).
+		ForUpdate().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return ErrNotFound
+	}
+	return err
+}
+
+func lockMedia(ctx context.Context, tx *ent.Tx, productID, mediaID int) (*ent.ProductMedia, error) {
+	m, err := tx.ProductMedia.
+		Query().
+		Where(productmedia.ID(mediaID), productmedia.ProductID(productID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrMediaNotFound
+		}
+		return nil, err
+	}
+	return m, nil
+}
+
+func clearPrimary(ctx context.Context, tx *ent.Tx, productID int) error {
+	return tx.ProductMedia.
+		Update().
+		Where(productmedia.ProductID(productID), productmedia.IsPrimary(true)).
+		SetIsPrimary(false).
+		Exec(ctx)
+}
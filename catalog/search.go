@@ -0,0 +1,225 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/law-a-1/product-service/ent"
+)
+
+var ErrEmptyQuery = errors.New("search query cannot be empty")
+
+// Highlighted terms are wrapped in these control characters by Postgres and
+// turned into <mark> tags once the rest of the text is HTML escaped.
+const (
+	highlightStart = "\x02"
+	highlightStop  = "\x03"
+)
+
+const fuzzySnippetLength = 160
+
+// SearchParams selects a page of search results.
+type SearchParams struct {
+	Query  string
+	Limit  int
+	Offset int
+}
+
+// Hit is a product matching a search together with its relevance and HTML
+// snippets highlighting the matched terms.
+type Hit struct {
+	Item
+	Rank                 float64
+	NameHighlight        string
+	DescriptionHighlight string
+}
+
+// SearchResult is a page of search hits ordered by relevance.
+type SearchResult struct {
+	Hits []Hit
+	// Total is the number of matching products across all pages.
+	Total int
+	// Fuzzy is set when nothing matched the query words and the hits are
+	// products with similarly spelled words instead.
+	Fuzzy bool
+}
+
+// Search finds the products whose name or description contain the words of
+// params.Query, ranking name matches above description matches. When no
+// product matches, it falls back to trigram similarity to tolerate typos.
+func Search(ctx context.Context, client *ent.Client, params SearchParams) (SearchResult, error) {
+	params.Query = strings.TrimSpace(params.Query)
+	if params.Query == "" {
+		return SearchResult{}, ErrEmptyQuery
+	}
+	if params.Limit <= 0 {
+		params.Limit = DefaultPageSize
+	}
+	if params.Limit > MaxPageSize {
+		params.Limit = MaxPageSize
+	}
+	if params.Offset < 0 {
+		params.Offset = 0
+	}
+
+	var result SearchResult
+	err := queryRow(ctx, client, &result.Total, `
+		SELECT count(*)
+		FROM products
+		WHERE search_vector @@ websearch_to_tsquery('simple', $1)`,
+		params.Query)
+	if err != nil {
+		return SearchResult{}, err
+	}
+
+	var hits []Hit
+	if result.Total > 0 {
+		hits, err = fullTextHits(ctx, client, params)
+	} else {
+		result.Fuzzy = true
+		err = queryRow(ctx, client, &result.Total, `
+			SELECT count(*)
+			FROM products
+			WHERE $1 <% name OR $1 <% description`,
+			params.Query)
+		if err == nil && result.Total > 0 {
+			hits, err = fuzzyHits(ctx, client, params)
+		}
+	}
+	if err != nil {
+		return SearchResult{}, err
+	}
+
+	result.Hits, err = withItems(ctx, client, hits)
+	if err != nil {
+		return SearchResult{}, err
+	}
+	return result, nil
+}
+
+func fullTextHits(ctx context.Context, client *ent.Client, params SearchParams) ([]Hit, error) {
+	nameOpts := fmt.Sprintf("StartSel=%s, StopSel=%s, HighlightAll=true", highlightStart, highlightStop)
+	descriptionOpts := fmt.Sprintf("StartSel=%s, StopSel=%s, MaxFragments=2, MaxWords=20, MinWords=5", highlightStart, highlightStop)
+	rows, err := client.QueryContext(ctx, `
+		SELECT p.id,
+			ts_rank_cd(p.search_vector, q.query) AS rank,
+			ts_headline('simple', p.name, q.query, $2),
+			ts_headline('simple', p.description, q.query, $3)
+		FROM products p, websearch_to_tsquery('simple', $1) AS q(query)
+		WHERE p.search_vector @@ q.query
+		ORDER BY rank DESC, p.id
+		LIMIT $4 OFFSET $5`,
+		params.Query, nameOpts, descriptionOpts, params.Limit, params.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var hits []Hit
+	for rows.Next() {
+		var h Hit
+		var id int
+		if err := rows.Scan(&id, &h.Rank, &h.NameHighlight, &h.DescriptionHighlight); err != nil {
+			return nil, err
+		}
+		h.Item.Product = &ent.Product{ID: id}
+		h.NameHighlight = highlight(h.NameHighlight)
+		h.DescriptionHighlight = highlight(h.DescriptionHighlight)
+		hits = append(hits, h)
+	}
+	return hits, rows.Err()
+}
+
+func fuzzyHits(ctx context.Context, client *ent.Client, params SearchParams) ([]Hit, error) {
+	rows, err := client.QueryContext(ctx, `
+		SELECT id,
+			greatest(word_similarity($1, name), word_similarity($1, description)) AS rank,
+			name,
+			description
+		FROM products
+		WHERE $1 <% name OR $1 <% description
+		ORDER BY rank DESC, id
+		LIMIT $2 OFFSET $3`,
+		params.Query, params.Limit, params.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var hits []Hit
+	for rows.Next() {
+		var h Hit
+		var id int
+		if err := rows.Scan(&id, &h.Rank, &h.NameHighlight, &h.DescriptionHighlight); err != nil {
+			return nil, err
+		}
+		h.Item.Product = &ent.Product{ID: id}
+		h.NameHighlight = html.EscapeString(h.NameHighlight)
+		h.DescriptionHighlight = html.EscapeString(truncate(h.DescriptionHighlight, fuzzySnippetLength))
+		hits = append(hits, h)
+	}
+	return hits, rows.Err()
+}
+
+// withItems replaces the placeholder products of hits with the stored ones,
+// keeping the order of hits.
+func withItems(ctx context.Context, client *ent.Client, hits []Hit) ([]Hit, error) {
+	ids := make([]int, 0, len(hits))
+	for _, h := range hits {
+		ids = append(ids, h.ID)
+	}
+	items, err := GetMany(ctx, client, ids...)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]Item, len(items))
+	for _, item := range items {
+		byID[item.ID] = item
+	}
+
+	found := make([]Hit, 0, len(hits))
+	for _, h := range hits {
+		// A product deleted between both queries is left out.
+		if item, ok := byID[h.ID]; ok {
+			h.Item = item
+			found = append(found, h)
+		}
+	}
+	return found, nil
+}
+
+func queryRow(ctx context.Context, client *ent.Client, dest interface{}, query string, args ...interface{}) error {
+	rows, err := client.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		return errors.New("query returned no rows")
+	}
+	if err := rows.Scan(dest); err != nil {
+		return err
+	}
+	return rows.Close()
+}
+
+// highlight HTML escapes a ts_headline snippet and marks its highlighted terms.
+func highlight(snippet string) string {
+	snippet = html.EscapeString(snippet)
+	snippet = strings.ReplaceAll(snippet, highlightStart, "<mark>")
+	return strings.ReplaceAll(snippet, highlightStop, "</mark>")
+}
+
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n]) + "…"
+}
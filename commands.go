@@ -0,0 +1,310 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/law-a-1/product-service/config"
+	"github.com/law-a-1/product-service/ent"
+	"github.com/law-a-1/product-service/ent/product"
+	"github.com/law-a-1/product-service/ent/stockmovement"
+	"github.com/law-a-1/product-service/migrations"
+	"github.com/law-a-1/product-service/stock"
+	"go.uber.org/zap"
+)
+
+// command is a subcommand of the binary.
+type command struct {
+	usage string
+	run   func(ctx context.Context, cfg config.Config, logger *zap.SugaredLogger, args []string) error
+}
+
+var commands = map[string]command{
+	"serve": {
+		usage: "serve                      run the HTTP and gRPC servers (default)",
+		run: func(ctx context.Context, cfg config.Config, logger *zap.SugaredLogger, _ []string) error {
+			return run(ctx, cfg, logger)
+		},
+	},
+	"migrate": {
+		usage: "migrate up|down [n]|status apply, revert or list database migrations",
+		run:   runMigrate,
+	},
+	"seed": {
+		usage: "seed <file>                create the products of a JSON or CSV fixture, without media",
+		run:   runSeed,
+	},
+	"export": {
+		usage: "export [-format json|csv]  write the catalog fields of all products to stdout",
+		run:   runExport,
+	},
+	"stock": {
+		usage: "stock adjust <id> <delta>  correct the stock of a product",
+		run:   runStock,
+	},
+}
+
+// printCommands writes the list of commands to w.
+func printCommands(w io.Writer) {
+	fmt.Fprintf(w, "\nCommands:\n")
+	for _, name := range []string{"serve", "migrate", "seed", "export", "stock"} {
+		fmt.Fprintf(w, "  %s\n", commands[name].usage)
+	}
+}
+
+// cliActor records changes made from the command line in the stock ledger.
+func cliActor(ctx context.Context) context.Context {
+	name := "cli"
+	if u := os.Getenv("USER"); u != "" {
+		name += ":" + u
+	}
+	return stock.WithActor(ctx, name)
+}
+
+// withPersistent runs fn with a client of the database. Like run, it refuses
+// an outdated schema, on which fn could fail halfway.
+func withPersistent(ctx context.Context, cfg config.Config, fn func(*ent.Client) error) error {
+	db, err := OpenDB(cfg.DB)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	persistent := NewPersistent(db)
+	defer persistent.Close()
+
+	migrator, err := migrations.New(db)
+	if err != nil {
+		return err
+	}
+	if err := migrator.Check(ctx); err != nil {
+		return fmt.Errorf("%w; run the migrate up command first", err)
+	}
+	return fn(persistent)
+}
+
+// fixtureProduct is a product of a seed fixture or an export. CSV files have
+// a header naming these fields. Fixtures hold the catalog fields only: image
+// variants and the media gallery are neither exported nor seeded, so they
+// have to be uploaded again after seeding.
+type fixtureProduct struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Price       int    `json:"price"`
+	Stock       int    `json:"stock"`
+	Image       string `json:"image,omitempty"`
+	Video       string `json:"video,omitempty"`
+}
+
+var fixtureHeader = []string{"name", "description", "price", "stock", "image", "video"}
+
+// readFixture reads the products of a .json or .csv file.
+func readFixture(path string) ([]fixtureProduct, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		var products []fixtureProduct
+		if err := json.NewDecoder(f).Decode(&products); err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", path, err)
+		}
+		return products, nil
+	case ".csv":
+		records, err := csv.NewReader(f).ReadAll()
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", path, err)
+		}
+		if len(records) == 0 {
+			return nil, nil
+		}
+		columns := make(map[string]int)
+		for i, name := range records[0] {
+			columns[strings.TrimSpace(strings.ToLower(name))] = i
+		}
+		for _, required := range fixtureHeader[:4] {
+			if _, ok := columns[required]; !ok {
+				return nil, fmt.Errorf("%s: missing column %q", path, required)
+			}
+		}
+		get := func(record []string, name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		products := make([]fixtureProduct, 0, len(records)-1)
+		for line, record := range records[1:] {
+			p := fixtureProduct{
+				Name:        get(record, "name"),
+				Description: get(record, "description"),
+				Image:       get(record, "image"),
+				Video:       get(record, "video"),
+			}
+			if p.Price, err = strconv.Atoi(get(record, "price")); err != nil {
+				return nil, fmt.Errorf("%s:%d: invalid price", path, line+2)
+			}
+			if p.Stock, err = strconv.Atoi(get(record, "stock")); err != nil {
+				return nil, fmt.Errorf("%s:%d: invalid stock", path, line+2)
+			}
+			products = append(products, p)
+		}
+		return products, nil
+	}
+	return nil, fmt.Errorf("%s: fixtures must be .json or .csv files", path)
+}
+
+// runSeed creates the products of a fixture. Products whose name already
+// exists are skipped, so a fixture can be loaded more than once.
+func runSeed(ctx context.Context, cfg config.Config, logger *zap.SugaredLogger, args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: seed <file.json|file.csv>")
+	}
+	products, err := readFixture(args[0])
+	if err != nil {
+		return err
+	}
+	for i, p := range products {
+		if p.Name == "" || p.Price < 0 || p.Stock < 0 {
+			return fmt.Errorf("product %d: name is required and price and stock cannot be negative", i+1)
+		}
+	}
+
+	return withPersistent(ctx, cfg, func(client *ent.Client) error {
+		ctx := cliActor(ctx)
+		var created, skipped int
+		err := stock.WithTx(ctx, client, func(tx *ent.Tx) error {
+			for _, p := range products {
+				exists, err := tx.Product.Query().Where(product.Name(p.Name)).Exist(ctx)
+				if err != nil {
+					return err
+				}
+				if exists {
+					skipped++
+					continue
+				}
+
+				c := tx.Product.
+					Create().
+					SetName(p.Name).
+					SetDescription(p.Description).
+					SetPrice(p.Price).
+					SetStock(p.Stock)
+				if p.Image != "" {
+					c.SetImage(p.Image)
+				}
+				if p.Video != "" {
+					c.SetVideo(p.Video)
+				}
+				saved, err := c.Save(ctx)
+				if err != nil {
+					return fmt.Errorf("creating %q: %w", p.Name, err)
+				}
+				if err := stock.Record(ctx, tx, saved.ID, saved.Stock, saved.Stock, stockmovement.ReasonInitial); err != nil {
+					return err
+				}
+				created++
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		logger.Infof("seeded %d products, skipped %d existing", created, skipped)
+		return nil
+	})
+}
+
+// runExport writes every product to stdout in the fixture format, so that an
+// export can be seeded into another database. Like the fixtures, it leaves
+// out image variants and the media gallery.
+func runExport(ctx context.Context, cfg config.Config, logger *zap.SugaredLogger, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	format := fs.String("format", "json", "output format, json or csv")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *format != "json" && *format != "csv" {
+		return fmt.Errorf("unknown export format %q", *format)
+	}
+
+	return withPersistent(ctx, cfg, func(client *ent.Client) error {
+		all, err := client.Product.Query().Order(ent.Asc(product.FieldID)).All(ctx)
+		if err != nil {
+			return err
+		}
+		products := make([]fixtureProduct, len(all))
+		for i, p := range all {
+			products[i] = fixtureProduct{
+				Name:        p.Name,
+				Description: p.Description,
+				Price:       p.Price,
+				Stock:       p.Stock,
+				Image:       p.Image,
+				Video:       p.Video,
+			}
+		}
+
+		if *format == "json" {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			return enc.Encode(products)
+		}
+		w := csv.NewWriter(os.Stdout)
+		if err := w.Write(fixtureHeader); err != nil {
+			return err
+		}
+		for _, p := range products {
+			err := w.Write([]string{p.Name, p.Description, strconv.Itoa(p.Price), strconv.Itoa(p.Stock), p.Image, p.Video})
+			if err != nil {
+				return err
+			}
+		}
+		w.Flush()
+		return w.Error()
+	})
+}
+
+// runStock runs "stock adjust [-reference id] <id> <delta>".
+func runStock(ctx context.Context, cfg config.Config, logger *zap.SugaredLogger, args []string) error {
+	if len(args) == 0 || args[0] != "adjust" {
+		return errors.New("usage: stock adjust [-reference id] <id> <delta>")
+	}
+	fs := flag.NewFlagSet("stock adjust", flag.ContinueOnError)
+	reference := fs.String("reference", "", "reference recorded with the adjustment, e.g. a stock count")
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+	if fs.NArg() != 2 {
+		return errors.New("usage: stock adjust [-reference id] <id> <delta>")
+	}
+	id, err := strconv.Atoi(fs.Arg(0))
+	if err != nil {
+		return fmt.Errorf("invalid product id %q", fs.Arg(0))
+	}
+	delta, err := strconv.Atoi(fs.Arg(1))
+	if err != nil {
+		return fmt.Errorf("invalid delta %q", fs.Arg(1))
+	}
+
+	return withPersistent(ctx, cfg, func(client *ent.Client) error {
+		ctx := stock.WithReference(cliActor(ctx), *reference)
+		remaining, err := stock.Adjust(ctx, client, id, delta)
+		if err != nil {
+			return fmt.Errorf("adjusting stock of product %d: %w", id, err)
+		}
+		logger.Infof("stock of product %d is now %d", id, remaining)
+		return nil
+	})
+}
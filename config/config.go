@@ -0,0 +1,281 @@
+// Package config loads the service configuration from defaults, an optional
+// YAML or TOML file, the environment and command line flags, in increasing
+// order of precedence.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Config is the configuration of the service. Every setting has a key in the
+// configuration file (the cfg tags joined by dots, e.g. db.max_open_conns), a
+// command line flag derived from that key (--db-max-open-conns) and, for most
+// settings, an environment variable (env tag).
+type Config struct {
+	HTTP            HTTP          `cfg:"http"`
+	GRPC            GRPC          `cfg:"grpc"`
+	DB              DB            `cfg:"db"`
+	TLS             TLS           `cfg:"tls"`
+	Auth            Auth          `cfg:"auth"`
+	Log             Log           `cfg:"log"`
+	Media           Media         `cfg:"media"`
+	Tracing         Tracing       `cfg:"tracing"`
+	ShutdownTimeout time.Duration `cfg:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT"`
+	// ShutdownDelay is how long the servers keep serving after readiness
+	// turns off, giving load balancers time to stop routing to them.
+	ShutdownDelay time.Duration `cfg:"shutdown_delay" env:"SHUTDOWN_DELAY"`
+}
+
+type HTTP struct {
+	Port              int           `cfg:"port" env:"PORT"`
+	ReadHeaderTimeout time.Duration `cfg:"read_header_timeout" env:"HTTP_READ_HEADER_TIMEOUT"`
+	ReadTimeout       time.Duration `cfg:"read_timeout" env:"HTTP_READ_TIMEOUT"`
+	WriteTimeout      time.Duration `cfg:"write_timeout" env:"HTTP_WRITE_TIMEOUT"`
+	IdleTimeout       time.Duration `cfg:"idle_timeout" env:"HTTP_IDLE_TIMEOUT"`
+	// ClientCAFile enables mTLS for HTTP clients.
+	ClientCAFile string `cfg:"client_ca_file" env:"HTTP_TLS_CLIENT_CA_FILE"`
+}
+
+type GRPC struct {
+	Port                 int           `cfg:"port" env:"GRPC_PORT"`
+	CallersFile          string        `cfg:"callers_file" env:"GRPC_CALLERS_FILE"`
+	ClientCAFile         string        `cfg:"client_ca_file" env:"GRPC_TLS_CLIENT_CA_FILE"`
+	IdempotencyRetention time.Duration `cfg:"idempotency_retention" env:"IDEMPOTENCY_RETENTION"`
+	// IdempotencyLease is how long a call may hold its idempotency key
+	// before a retry takes it over, e.g. after the replica crashed.
+	IdempotencyLease time.Duration `cfg:"idempotency_lease" env:"IDEMPOTENCY_LEASE"`
+}
+
+type DB struct {
+	Host     string `cfg:"host" env:"DB_HOST"`
+	Port     int    `cfg:"port" env:"DB_PORT"`
+	User     string `cfg:"user" env:"DB_USER"`
+	Password string `cfg:"password" env:"DB_PASS"`
+	Name     string `cfg:"name" env:"DB_NAME"`
+	// SSLMode is a libpq sslmode: disable, allow, prefer, require,
+	// verify-ca or verify-full.
+	SSLMode         string        `cfg:"sslmode" env:"DB_SSLMODE"`
+	SSLRootCert     string        `cfg:"sslrootcert" env:"DB_SSLROOTCERT"`
+	ConnectTimeout  time.Duration `cfg:"connect_timeout" env:"DB_CONNECT_TIMEOUT"`
+	MaxOpenConns    int           `cfg:"max_open_conns" env:"DB_MAX_OPEN_CONNS"`
+	MaxIdleConns    int           `cfg:"max_idle_conns" env:"DB_MAX_IDLE_CONNS"`
+	ConnMaxLifetime time.Duration `cfg:"conn_max_lifetime" env:"DB_CONN_MAX_LIFETIME"`
+	ConnMaxIdleTime time.Duration `cfg:"conn_max_idle_time" env:"DB_CONN_MAX_IDLE_TIME"`
+}
+
+// DSN returns the connection URL of the database.
+func (c DB) DSN() string {
+	q := url.Values{}
+	q.Set("sslmode", c.SSLMode)
+	if c.SSLRootCert != "" {
+		q.Set("sslrootcert", c.SSLRootCert)
+	}
+	if c.ConnectTimeout > 0 {
+		q.Set("connect_timeout", fmt.Sprint(int(c.ConnectTimeout.Seconds())))
+	}
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(c.User, c.Password),
+		Host:     fmt.Sprintf("%s:%d", c.Host, c.Port),
+		Path:     "/" + c.Name,
+		RawQuery: q.Encode(),
+	}
+	return u.String()
+}
+
+type TLS struct {
+	CertFile string `cfg:"cert_file" env:"TLS_CERT_FILE"`
+	KeyFile  string `cfg:"key_file" env:"TLS_KEY_FILE"`
+}
+
+// Enabled reports whether the listeners serve TLS.
+func (c TLS) Enabled() bool {
+	return c.CertFile != "" || c.KeyFile != ""
+}
+
+type Auth struct {
+	// Mode is remote, jwt or hybrid.
+	Mode                 string        `cfg:"mode" env:"AUTH_MODE"`
+	IntrospectionURL     string        `cfg:"introspection_url" env:"AUTH_INTROSPECTION_URL"`
+	IntrospectionTimeout time.Duration `cfg:"introspection_timeout" env:"AUTH_INTROSPECTION_TIMEOUT"`
+	CacheTTL             time.Duration `cfg:"cache_ttl" env:"AUTH_CACHE_TTL"`
+	PolicyFile           string        `cfg:"policy_file" env:"AUTH_POLICY_FILE"`
+	JWTSecret            string        `cfg:"jwt_secret" env:"JWT_SECRET"`
+	JWKSURL              string        `cfg:"jwks_url" env:"JWKS_URL"`
+	JWKSFile             string        `cfg:"jwks_file" env:"JWKS_FILE"`
+	JWKSRefresh          time.Duration `cfg:"jwks_refresh" env:"JWKS_REFRESH"`
+	JWTIssuer            string        `cfg:"jwt_issuer" env:"JWT_ISSUER"`
+	JWTAudience          string        `cfg:"jwt_audience" env:"JWT_AUDIENCE"`
+}
+
+type Log struct {
+	// Level is debug, info, warn or error.
+	Level string `cfg:"level" env:"LOG_LEVEL"`
+	// Format is console or json.
+	Format string `cfg:"format" env:"LOG_FORMAT"`
+	// ServiceURL is the log service an entry of every JSON response is
+	// shipped to, one per request. Entries are taken off the queue in batches
+	// of ShipBatchSize, at least every ShipFlushInterval. Up to ShipQueueSize
+	// entries wait to be shipped; further entries are dropped.
+	ServiceURL        string        `cfg:"service_url" env:"LOG_SERVICE_URL"`
+	ShipQueueSize     int           `cfg:"ship_queue_size" env:"LOG_SHIP_QUEUE_SIZE"`
+	ShipBatchSize     int           `cfg:"ship_batch_size" env:"LOG_SHIP_BATCH_SIZE"`
+	ShipFlushInterval time.Duration `cfg:"ship_flush_interval" env:"LOG_SHIP_FLUSH_INTERVAL"`
+}
+
+type Media struct {
+	Dir     string `cfg:"dir" env:"MEDIA_DIR"`
+	BaseURL string `cfg:"base_url" env:"MEDIA_BASE_URL"`
+}
+
+type Tracing struct {
+	// Exporter is none, otlp or stdout. otlp sends spans to the collector
+	// at Endpoint over gRPC, in plaintext when Insecure is set.
+	Exporter string `cfg:"exporter" env:"TRACING_EXPORTER"`
+	Endpoint string `cfg:"endpoint" env:"TRACING_ENDPOINT"`
+	Insecure bool   `cfg:"insecure" env:"TRACING_INSECURE"`
+	// SampleRatio is the fraction of new traces recorded. Traces started
+	// upstream follow the sampling decision of their caller.
+	SampleRatio float64 `cfg:"sample_ratio" env:"TRACING_SAMPLE_RATIO"`
+}
+
+// Default returns the configuration used for settings that are not set
+// anywhere else.
+func Default() Config {
+	return Config{
+		HTTP: HTTP{
+			Port:              8080,
+			ReadHeaderTimeout: 10 * time.Second,
+			ReadTimeout:       time.Minute,
+			WriteTimeout:      time.Minute,
+			IdleTimeout:       2 * time.Minute,
+		},
+		GRPC: GRPC{
+			Port:                 50051,
+			IdempotencyRetention: 24 * time.Hour,
+			IdempotencyLease:     time.Minute,
+		},
+		DB: DB{
+			Host:            "localhost",
+			Port:            5432,
+			SSLMode:         "disable",
+			ConnectTimeout:  10 * time.Second,
+			MaxOpenConns:    25,
+			MaxIdleConns:    25,
+			ConnMaxLifetime: 5 * time.Minute,
+		},
+		Auth: Auth{
+			Mode:                 "remote",
+			IntrospectionURL:     "https://auth-law-a1.herokuapp.com/user",
+			IntrospectionTimeout: 3 * time.Second,
+			CacheTTL:             time.Minute,
+			JWKSRefresh:          time.Hour,
+		},
+		Log: Log{
+			Level:             "info",
+			Format:            "console",
+			ShipQueueSize:     10000,
+			ShipBatchSize:     100,
+			ShipFlushInterval: 2 * time.Second,
+		},
+		Media: Media{
+			Dir: "./media",
+		},
+		Tracing: Tracing{
+			Exporter:    "none",
+			Endpoint:    "localhost:4317",
+			Insecure:    true,
+			SampleRatio: 1,
+		},
+		ShutdownTimeout: 30 * time.Second,
+	}
+}
+
+// Validate reports every invalid setting of c.
+func (c Config) Validate() error {
+	var errs []error
+	check := func(ok bool, key, format string, args ...interface{}) {
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: %s", key, fmt.Sprintf(format, args...)))
+		}
+	}
+	oneOf := func(v string, allowed ...string) bool {
+		for _, a := range allowed {
+			if v == a {
+				return true
+			}
+		}
+		return false
+	}
+
+	check(validPort(c.HTTP.Port), "http.port", "must be between 1 and 65535, got %d", c.HTTP.Port)
+	check(validPort(c.GRPC.Port), "grpc.port", "must be between 1 and 65535, got %d", c.GRPC.Port)
+	check(c.HTTP.Port != c.GRPC.Port, "grpc.port", "must differ from http.port")
+	check(c.HTTP.ReadHeaderTimeout > 0, "http.read_header_timeout", "must be positive")
+	check(c.HTTP.ReadTimeout >= 0, "http.read_timeout", "must not be negative")
+	check(c.HTTP.WriteTimeout >= 0, "http.write_timeout", "must not be negative")
+	check(c.HTTP.IdleTimeout >= 0, "http.idle_timeout", "must not be negative")
+	check(c.GRPC.IdempotencyRetention > 0, "grpc.idempotency_retention", "must be positive")
+	check(c.GRPC.IdempotencyLease > 0, "grpc.idempotency_lease", "must be positive")
+
+	check(c.DB.Host != "", "db.host", "is required")
+	check(validPort(c.DB.Port), "db.port", "must be between 1 and 65535, got %d", c.DB.Port)
+	check(c.DB.User != "", "db.user", "is required")
+	check(c.DB.Name != "", "db.name", "is required")
+	check(oneOf(c.DB.SSLMode, "disable", "allow", "prefer", "require", "verify-ca", "verify-full"),
+		"db.sslmode", "must be one of disable, allow, prefer, require, verify-ca, verify-full, got %q", c.DB.SSLMode)
+	check(c.DB.ConnectTimeout >= 0, "db.connect_timeout", "must not be negative")
+	check(c.DB.MaxOpenConns > 0, "db.max_open_conns", "must be positive")
+	check(c.DB.MaxIdleConns >= 0 && c.DB.MaxIdleConns <= c.DB.MaxOpenConns,
+		"db.max_idle_conns", "must be between 0 and db.max_open_conns (%d)", c.DB.MaxOpenConns)
+	check(c.DB.ConnMaxLifetime >= 0, "db.conn_max_lifetime", "must not be negative")
+	check(c.DB.ConnMaxIdleTime >= 0, "db.conn_max_idle_time", "must not be negative")
+
+	check(c.TLS.CertFile != "" || c.TLS.KeyFile == "", "tls.cert_file", "is required with tls.key_file")
+	check(c.TLS.KeyFile != "" || c.TLS.CertFile == "", "tls.key_file", "is required with tls.cert_file")
+	check(c.HTTP.ClientCAFile == "" || c.TLS.Enabled(), "http.client_ca_file", "requires tls.cert_file and tls.key_file")
+	check(c.GRPC.ClientCAFile == "" || c.TLS.Enabled(), "grpc.client_ca_file", "requires tls.cert_file and tls.key_file")
+
+	check(oneOf(c.Auth.Mode, "remote", "jwt", "hybrid"), "auth.mode", "must be remote, jwt or hybrid, got %q", c.Auth.Mode)
+	if c.Auth.Mode == "remote" || c.Auth.Mode == "hybrid" {
+		check(validURL(c.Auth.IntrospectionURL), "auth.introspection_url", "must be an http(s) url, got %q", c.Auth.IntrospectionURL)
+		check(c.Auth.IntrospectionTimeout > 0, "auth.introspection_timeout", "must be positive")
+		check(c.Auth.CacheTTL > 0, "auth.cache_ttl", "must be positive")
+	}
+	if c.Auth.Mode == "jwt" || c.Auth.Mode == "hybrid" {
+		check(c.Auth.JWTSecret != "" || c.Auth.JWKSURL != "" || c.Auth.JWKSFile != "",
+			"auth", "mode %s needs jwt_secret, jwks_url or jwks_file", c.Auth.Mode)
+		check(c.Auth.JWKSURL == "" || validURL(c.Auth.JWKSURL), "auth.jwks_url", "must be an http(s) url, got %q", c.Auth.JWKSURL)
+		check(c.Auth.JWKSRefresh > 0, "auth.jwks_refresh", "must be positive")
+	}
+
+	check(oneOf(c.Log.Level, "debug", "info", "warn", "error"), "log.level", "must be debug, info, warn or error, got %q", c.Log.Level)
+	check(oneOf(c.Log.Format, "console", "json"), "log.format", "must be console or json, got %q", c.Log.Format)
+	check(c.Log.ServiceURL == "" || validURL(c.Log.ServiceURL), "log.service_url", "must be an http(s) url, got %q", c.Log.ServiceURL)
+	check(c.Log.ShipQueueSize > 0, "log.ship_queue_size", "must be positive")
+	check(c.Log.ShipBatchSize > 0 && c.Log.ShipBatchSize <= c.Log.ShipQueueSize,
+		"log.ship_batch_size", "must be between 1 and log.ship_queue_size (%d)", c.Log.ShipQueueSize)
+	check(c.Log.ShipFlushInterval > 0, "log.ship_flush_interval", "must be positive")
+
+	check(c.Media.Dir != "", "media.dir", "is required")
+
+	check(oneOf(c.Tracing.Exporter, "none", "otlp", "stdout"), "tracing.exporter", "must be none, otlp or stdout, got %q", c.Tracing.Exporter)
+	check(c.Tracing.Exporter != "otlp" || c.Tracing.Endpoint != "", "tracing.endpoint", "is required with the otlp exporter")
+	check(c.Tracing.SampleRatio >= 0 && c.Tracing.SampleRatio <= 1, "tracing.sample_ratio", "must be between 0 and 1, got %g", c.Tracing.SampleRatio)
+	check(c.ShutdownTimeout > 0, "shutdown_timeout", "must be positive")
+	check(c.ShutdownDelay >= 0, "shutdown_delay", "must not be negative")
+
+	return errors.Join(errs...)
+}
+
+func validPort(p int) bool {
+	return p > 0 && p <= 65535
+}
+
+func validURL(s string) bool {
+	u, err := url.Parse(s)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
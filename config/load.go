@@ -0,0 +1,213 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// setting is a leaf field of Config.
+type setting struct {
+	key   string
+	env   string
+	value reflect.Value
+}
+
+// flagName returns the command line flag of the setting, e.g. db-max-open-conns
+// for db.max_open_conns.
+func (s setting) flagName() string {
+	return strings.NewReplacer(".", "-", "_", "-").Replace(s.key)
+}
+
+func settings(c *Config) []setting {
+	var out []setting
+	var walk func(v reflect.Value, prefix string)
+	walk = func(v reflect.Value, prefix string) {
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			key := f.Tag.Get("cfg")
+			if key == "" {
+				continue
+			}
+			if prefix != "" {
+				key = prefix + "." + key
+			}
+			if f.Type.Kind() == reflect.Struct {
+				walk(v.Field(i), key)
+				continue
+			}
+			out = append(out, setting{key: key, env: f.Tag.Get("env"), value: v.Field(i)})
+		}
+	}
+	walk(reflect.ValueOf(c).Elem(), "")
+	return out
+}
+
+// Load returns the configuration for the command line args, which exclude the
+// program name. Settings are taken from the defaults, then the file named by
+// the --config flag or CONFIG_FILE, then the environment, then the flags. The
+// result is validated. Arguments after the flags are returned.
+func Load(name string, args []string) (Config, []string, error) {
+	cfg := Default()
+	all := settings(&cfg)
+
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	path := fs.String("config", os.Getenv("CONFIG_FILE"), "configuration file (.yaml, .yml or .toml) [$CONFIG_FILE]")
+	flags := make(map[string]string)
+	for _, s := range all {
+		s := s
+		usage := fmt.Sprintf("%s (default %q)", s.key, format(s.value))
+		if s.env != "" {
+			usage = fmt.Sprintf("%s [$%s] (default %q)", s.key, s.env, format(s.value))
+		}
+		fs.Func(s.flagName(), usage, func(v string) error {
+			flags[s.key] = v
+			return nil
+		})
+	}
+	if err := fs.Parse(args); err != nil {
+		return Config{}, nil, err
+	}
+
+	if *path != "" {
+		values, err := readFile(*path)
+		if err != nil {
+			return Config{}, nil, err
+		}
+		for _, s := range all {
+			if v, ok := values[s.key]; ok {
+				if err := set(s.value, v); err != nil {
+					return Config{}, nil, fmt.Errorf("%s: %s: %w", *path, s.key, err)
+				}
+				delete(values, s.key)
+			}
+		}
+		for key := range values {
+			return Config{}, nil, fmt.Errorf("%s: unknown setting %q", *path, key)
+		}
+	}
+
+	for _, s := range all {
+		if s.env == "" {
+			continue
+		}
+		if v, ok := os.LookupEnv(s.env); ok && v != "" {
+			if err := set(s.value, v); err != nil {
+				return Config{}, nil, fmt.Errorf("$%s: %w", s.env, err)
+			}
+		}
+	}
+
+	for _, s := range all {
+		if v, ok := flags[s.key]; ok {
+			if err := set(s.value, v); err != nil {
+				return Config{}, nil, fmt.Errorf("--%s: %w", s.flagName(), err)
+			}
+		}
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return Config{}, nil, fmt.Errorf("invalid configuration:\n%w", err)
+	}
+	return cfg, fs.Args(), nil
+}
+
+// readFile reads a YAML or TOML file into a map from dotted keys to values.
+func readFile(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading configuration: %w", err)
+	}
+
+	tree := make(map[string]interface{})
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &tree)
+	case ".toml":
+		err = toml.Unmarshal(data, &tree)
+	default:
+		return nil, fmt.Errorf("configuration file %s: unsupported format %q", path, ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+
+	values := make(map[string]string)
+	var flatten func(m map[string]interface{}, prefix string) error
+	flatten = func(m map[string]interface{}, prefix string) error {
+		for k, v := range m {
+			if prefix != "" {
+				k = prefix + "." + k
+			}
+			switch v := v.(type) {
+			case map[string]interface{}:
+				if err := flatten(v, k); err != nil {
+					return err
+				}
+			case string, bool, int, int64, uint64, float64:
+				values[k] = fmt.Sprint(v)
+			case nil:
+			default:
+				return fmt.Errorf("%s: %s: unsupported value %v", path, k, v)
+			}
+		}
+		return nil
+	}
+	if err := flatten(tree, ""); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+func set(v reflect.Value, s string) error {
+	switch {
+	case v.Type() == durationType:
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return fmt.Errorf("invalid duration %q", s)
+		}
+		v.SetInt(int64(d))
+	case v.Kind() == reflect.Int:
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", s)
+		}
+		v.SetInt(int64(n))
+	case v.Kind() == reflect.Float64:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return fmt.Errorf("invalid number %q", s)
+		}
+		v.SetFloat(f)
+	case v.Kind() == reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return fmt.Errorf("invalid boolean %q", s)
+		}
+		v.SetBool(b)
+	case v.Kind() == reflect.String:
+		v.SetString(s)
+	default:
+		return errors.New("unsupported setting type " + v.Type().String())
+	}
+	return nil
+}
+
+func format(v reflect.Value) string {
+	if v.Type() == durationType {
+		return time.Duration(v.Int()).String()
+	}
+	return fmt.Sprint(v.Interface())
+}
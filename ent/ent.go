@@ -10,7 +10,11 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/law-a-1/product-service/ent/idempotencykey"
 	"github.com/law-a-1/product-service/ent/product"
+	"github.com/law-a-1/product-service/ent/productmedia"
+	"github.com/law-a-1/product-service/ent/reservation"
+	"github.com/law-a-1/product-service/ent/stockmovement"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -31,7 +35,11 @@ type OrderFunc func(*sql.Selector)
 // columnChecker returns a function indicates if the column exists in the given column.
 func columnChecker(table string) func(string) error {
 	checks := map[string]func(string) bool{
-		product.Table: product.ValidColumn,
+		idempotencykey.Table: idempotencykey.ValidColumn,
+		product.Table:        product.ValidColumn,
+		productmedia.Table:   productmedia.ValidColumn,
+		reservation.Table:    reservation.ValidColumn,
+		stockmovement.Table:  stockmovement.ValidColumn,
 	}
 	check, ok := checks[table]
 	if !ok {
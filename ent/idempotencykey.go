@@ -0,0 +1,194 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/law-a-1/product-service/ent/idempotencykey"
+)
+
+// IdempotencyKey is the model entity for the IdempotencyKey schema.
+type IdempotencyKey struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Key holds the value of the "key" field.
+	Key string `json:"key,omitempty"`
+	// Caller holds the value of the "caller" field.
+	Caller string `json:"caller,omitempty"`
+	// Method holds the value of the "method" field.
+	Method string `json:"method,omitempty"`
+	// RequestHash holds the value of the "request_hash" field.
+	RequestHash string `json:"request_hash,omitempty"`
+	// State holds the value of the "state" field.
+	State idempotencykey.State `json:"state,omitempty"`
+	// Response holds the value of the "response" field.
+	Response []byte `json:"response,omitempty"`
+	// Status holds the value of the "status" field.
+	Status []byte `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ClaimedAt holds the value of the "claimed_at" field.
+	ClaimedAt time.Time `json:"claimed_at,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*IdempotencyKey) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case idempotencykey.FieldResponse, idempotencykey.FieldStatus:
+			values[i] = new([]byte)
+		case idempotencykey.FieldID:
+			values[i] = new(sql.NullInt64)
+		case idempotencykey.FieldKey, idempotencykey.FieldCaller, idempotencykey.FieldMethod, idempotencykey.FieldRequestHash, idempotencykey.FieldState:
+			values[i] = new(sql.NullString)
+		case idempotencykey.FieldCreatedAt, idempotencykey.FieldClaimedAt, idempotencykey.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type IdempotencyKey", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the IdempotencyKey fields.
+func (ik *IdempotencyKey) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case idempotencykey.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ik.ID = int(value.Int64)
+		case idempotencykey.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				ik.Key = value.String
+			}
+		case idempotencykey.FieldCaller:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field caller", values[i])
+			} else if value.Valid {
+				ik.Caller = value.String
+			}
+		case idempotencykey.FieldMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field method", values[i])
+			} else if value.Valid {
+				ik.Method = value.String
+			}
+		case idempotencykey.FieldRequestHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field request_hash", values[i])
+			} else if value.Valid {
+				ik.RequestHash = value.String
+			}
+		case idempotencykey.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				ik.State = idempotencykey.State(value.String)
+			}
+		case idempotencykey.FieldResponse:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field response", values[i])
+			} else if value != nil {
+				ik.Response = *value
+			}
+		case idempotencykey.FieldStatus:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value != nil {
+				ik.Status = *value
+			}
+		case idempotencykey.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ik.CreatedAt = value.Time
+			}
+		case idempotencykey.FieldClaimedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field claimed_at", values[i])
+			} else if value.Valid {
+				ik.ClaimedAt = value.Time
+			}
+		case idempotencykey.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				ik.ExpiresAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this IdempotencyKey.
+// Note that you need to call IdempotencyKey.Unwrap() before calling this method if this IdempotencyKey
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ik *IdempotencyKey) Update() *IdempotencyKeyUpdateOne {
+	return (&IdempotencyKeyClient{config: ik.config}).UpdateOne(ik)
+}
+
+// Unwrap unwraps the IdempotencyKey entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ik *IdempotencyKey) Unwrap() *IdempotencyKey {
+	tx, ok := ik.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: IdempotencyKey is not a transactional entity")
+	}
+	ik.config.driver = tx.drv
+	return ik
+}
+
+// String implements the fmt.Stringer.
+func (ik *IdempotencyKey) String() string {
+	var builder strings.Builder
+	builder.WriteString("IdempotencyKey(")
+	builder.WriteString(fmt.Sprintf("id=%v", ik.ID))
+	builder.WriteString(", key=")
+	builder.WriteString(ik.Key)
+	builder.WriteString(", caller=")
+	builder.WriteString(ik.Caller)
+	builder.WriteString(", method=")
+	builder.WriteString(ik.Method)
+	builder.WriteString(", request_hash=")
+	builder.WriteString(ik.RequestHash)
+	builder.WriteString(", state=")
+	builder.WriteString(fmt.Sprintf("%v", ik.State))
+	builder.WriteString(", response=")
+	builder.WriteString(fmt.Sprintf("%v", ik.Response))
+	builder.WriteString(", status=")
+	builder.WriteString(fmt.Sprintf("%v", ik.Status))
+	builder.WriteString(", created_at=")
+	builder.WriteString(ik.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", claimed_at=")
+	builder.WriteString(ik.ClaimedAt.Format(time.ANSIC))
+	builder.WriteString(", expires_at=")
+	builder.WriteString(ik.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// IdempotencyKeys is a parsable slice of IdempotencyKey.
+type IdempotencyKeys []*IdempotencyKey
+
+func (ik IdempotencyKeys) config(cfg config) {
+	for _i := range ik {
+		ik[_i].config = cfg
+	}
+}
@@ -0,0 +1,442 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/law-a-1/product-service/ent/idempotencykey"
+)
+
+// IdempotencyKeyCreate is the builder for creating a IdempotencyKey entity.
+type IdempotencyKeyCreate struct {
+	config
+	mutation *IdempotencyKeyMutation
+	hooks    []Hook
+}
+
+// SetKey sets the "key" field.
+func (ikc *IdempotencyKeyCreate) SetKey(s string) *IdempotencyKeyCreate {
+	ikc.mutation.SetKey(s)
+	return ikc
+}
+
+// SetCaller sets the "caller" field.
+func (ikc *IdempotencyKeyCreate) SetCaller(s string) *IdempotencyKeyCreate {
+	ikc.mutation.SetCaller(s)
+	return ikc
+}
+
+// SetNillableCaller sets the "caller" field if the given value is not nil.
+func (ikc *IdempotencyKeyCreate) SetNillableCaller(s *string) *IdempotencyKeyCreate {
+	if s != nil {
+		ikc.SetCaller(*s)
+	}
+	return ikc
+}
+
+// SetMethod sets the "method" field.
+func (ikc *IdempotencyKeyCreate) SetMethod(s string) *IdempotencyKeyCreate {
+	ikc.mutation.SetMethod(s)
+	return ikc
+}
+
+// SetRequestHash sets the "request_hash" field.
+func (ikc *IdempotencyKeyCreate) SetRequestHash(s string) *IdempotencyKeyCreate {
+	ikc.mutation.SetRequestHash(s)
+	return ikc
+}
+
+// SetState sets the "state" field.
+func (ikc *IdempotencyKeyCreate) SetState(i idempotencykey.State) *IdempotencyKeyCreate {
+	ikc.mutation.SetState(i)
+	return ikc
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (ikc *IdempotencyKeyCreate) SetNillableState(i *idempotencykey.State) *IdempotencyKeyCreate {
+	if i != nil {
+		ikc.SetState(*i)
+	}
+	return ikc
+}
+
+// SetResponse sets the "response" field.
+func (ikc *IdempotencyKeyCreate) SetResponse(b []byte) *IdempotencyKeyCreate {
+	ikc.mutation.SetResponse(b)
+	return ikc
+}
+
+// SetStatus sets the "status" field.
+func (ikc *IdempotencyKeyCreate) SetStatus(b []byte) *IdempotencyKeyCreate {
+	ikc.mutation.SetStatus(b)
+	return ikc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ikc *IdempotencyKeyCreate) SetCreatedAt(t time.Time) *IdempotencyKeyCreate {
+	ikc.mutation.SetCreatedAt(t)
+	return ikc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ikc *IdempotencyKeyCreate) SetNillableCreatedAt(t *time.Time) *IdempotencyKeyCreate {
+	if t != nil {
+		ikc.SetCreatedAt(*t)
+	}
+	return ikc
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (ikc *IdempotencyKeyCreate) SetClaimedAt(t time.Time) *IdempotencyKeyCreate {
+	ikc.mutation.SetClaimedAt(t)
+	return ikc
+}
+
+// SetNillableClaimedAt sets the "claimed_at" field if the given value is not nil.
+func (ikc *IdempotencyKeyCreate) SetNillableClaimedAt(t *time.Time) *IdempotencyKeyCreate {
+	if t != nil {
+		ikc.SetClaimedAt(*t)
+	}
+	return ikc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ikc *IdempotencyKeyCreate) SetExpiresAt(t time.Time) *IdempotencyKeyCreate {
+	ikc.mutation.SetExpiresAt(t)
+	return ikc
+}
+
+// Mutation returns the IdempotencyKeyMutation object of the builder.
+func (ikc *IdempotencyKeyCreate) Mutation() *IdempotencyKeyMutation {
+	return ikc.mutation
+}
+
+// Save creates the IdempotencyKey in the database.
+func (ikc *IdempotencyKeyCreate) Save(ctx context.Context) (*IdempotencyKey, error) {
+	var (
+		err  error
+		node *IdempotencyKey
+	)
+	ikc.defaults()
+	if len(ikc.hooks) == 0 {
+		if err = ikc.check(); err != nil {
+			return nil, err
+		}
+		node, err = ikc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*IdempotencyKeyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ikc.check(); err != nil {
+				return nil, err
+			}
+			ikc.mutation = mutation
+			if node, err = ikc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ikc.hooks) - 1; i >= 0; i-- {
+			if ikc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ikc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ikc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ikc *IdempotencyKeyCreate) SaveX(ctx context.Context) *IdempotencyKey {
+	v, err := ikc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ikc *IdempotencyKeyCreate) Exec(ctx context.Context) error {
+	_, err := ikc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ikc *IdempotencyKeyCreate) ExecX(ctx context.Context) {
+	if err := ikc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ikc *IdempotencyKeyCreate) defaults() {
+	if _, ok := ikc.mutation.Caller(); !ok {
+		v := idempotencykey.DefaultCaller
+		ikc.mutation.SetCaller(v)
+	}
+	if _, ok := ikc.mutation.State(); !ok {
+		v := idempotencykey.DefaultState
+		ikc.mutation.SetState(v)
+	}
+	if _, ok := ikc.mutation.CreatedAt(); !ok {
+		v := idempotencykey.DefaultCreatedAt()
+		ikc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ikc.mutation.ClaimedAt(); !ok {
+		v := idempotencykey.DefaultClaimedAt()
+		ikc.mutation.SetClaimedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ikc *IdempotencyKeyCreate) check() error {
+	if _, ok := ikc.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "IdempotencyKey.key"`)}
+	}
+	if v, ok := ikc.mutation.Key(); ok {
+		if err := idempotencykey.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "IdempotencyKey.key": %w`, err)}
+		}
+	}
+	if _, ok := ikc.mutation.Caller(); !ok {
+		return &ValidationError{Name: "caller", err: errors.New(`ent: missing required field "IdempotencyKey.caller"`)}
+	}
+	if _, ok := ikc.mutation.Method(); !ok {
+		return &ValidationError{Name: "method", err: errors.New(`ent: missing required field "IdempotencyKey.method"`)}
+	}
+	if v, ok := ikc.mutation.Method(); ok {
+		if err := idempotencykey.MethodValidator(v); err != nil {
+			return &ValidationError{Name: "method", err: fmt.Errorf(`ent: validator failed for field "IdempotencyKey.method": %w`, err)}
+		}
+	}
+	if _, ok := ikc.mutation.RequestHash(); !ok {
+		return &ValidationError{Name: "request_hash", err: errors.New(`ent: missing required field "IdempotencyKey.request_hash"`)}
+	}
+	if v, ok := ikc.mutation.RequestHash(); ok {
+		if err := idempotencykey.RequestHashValidator(v); err != nil {
+			return &ValidationError{Name: "request_hash", err: fmt.Errorf(`ent: validator failed for field "IdempotencyKey.request_hash": %w`, err)}
+		}
+	}
+	if _, ok := ikc.mutation.State(); !ok {
+		return &ValidationError{Name: "state", err: errors.New(`ent: missing required field "IdempotencyKey.state"`)}
+	}
+	if v, ok := ikc.mutation.State(); ok {
+		if err := idempotencykey.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "IdempotencyKey.state": %w`, err)}
+		}
+	}
+	if _, ok := ikc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "IdempotencyKey.created_at"`)}
+	}
+	if _, ok := ikc.mutation.ClaimedAt(); !ok {
+		return &ValidationError{Name: "claimed_at", err: errors.New(`ent: missing required field "IdempotencyKey.claimed_at"`)}
+	}
+	if _, ok := ikc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "IdempotencyKey.expires_at"`)}
+	}
+	return nil
+}
+
+func (ikc *IdempotencyKeyCreate) sqlSave(ctx context.Context) (*IdempotencyKey, error) {
+	_node, _spec := ikc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ikc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (ikc *IdempotencyKeyCreate) createSpec() (*IdempotencyKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &IdempotencyKey{config: ikc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: idempotencykey.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: idempotencykey.FieldID,
+			},
+		}
+	)
+	if value, ok := ikc.mutation.Key(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: idempotencykey.FieldKey,
+		})
+		_node.Key = value
+	}
+	if value, ok := ikc.mutation.Caller(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: idempotencykey.FieldCaller,
+		})
+		_node.Caller = value
+	}
+	if value, ok := ikc.mutation.Method(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: idempotencykey.FieldMethod,
+		})
+		_node.Method = value
+	}
+	if value, ok := ikc.mutation.RequestHash(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: idempotencykey.FieldRequestHash,
+		})
+		_node.RequestHash = value
+	}
+	if value, ok := ikc.mutation.State(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: idempotencykey.FieldState,
+		})
+		_node.State = value
+	}
+	if value, ok := ikc.mutation.Response(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: idempotencykey.FieldResponse,
+		})
+		_node.Response = value
+	}
+	if value, ok := ikc.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: idempotencykey.FieldStatus,
+		})
+		_node.Status = value
+	}
+	if value, ok := ikc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: idempotencykey.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := ikc.mutation.ClaimedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: idempotencykey.FieldClaimedAt,
+		})
+		_node.ClaimedAt = value
+	}
+	if value, ok := ikc.mutation.ExpiresAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: idempotencykey.FieldExpiresAt,
+		})
+		_node.ExpiresAt = value
+	}
+	return _node, _spec
+}
+
+// IdempotencyKeyCreateBulk is the builder for creating many IdempotencyKey entities in bulk.
+type IdempotencyKeyCreateBulk struct {
+	config
+	builders []*IdempotencyKeyCreate
+}
+
+// Save creates the IdempotencyKey entities in the database.
+func (ikcb *IdempotencyKeyCreateBulk) Save(ctx context.Context) ([]*IdempotencyKey, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ikcb.builders))
+	nodes := make([]*IdempotencyKey, len(ikcb.builders))
+	mutators := make([]Mutator, len(ikcb.builders))
+	for i := range ikcb.builders {
+		func(i int, root context.Context) {
+			builder := ikcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*IdempotencyKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ikcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ikcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ikcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ikcb *IdempotencyKeyCreateBulk) SaveX(ctx context.Context) []*IdempotencyKey {
+	v, err := ikcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ikcb *IdempotencyKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := ikcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ikcb *IdempotencyKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := ikcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,632 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/law-a-1/product-service/ent/idempotencykey"
+	"github.com/law-a-1/product-service/ent/predicate"
+)
+
+// IdempotencyKeyUpdate is the builder for updating IdempotencyKey entities.
+type IdempotencyKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *IdempotencyKeyMutation
+}
+
+// Where appends a list predicates to the IdempotencyKeyUpdate builder.
+func (iku *IdempotencyKeyUpdate) Where(ps ...predicate.IdempotencyKey) *IdempotencyKeyUpdate {
+	iku.mutation.Where(ps...)
+	return iku
+}
+
+// SetKey sets the "key" field.
+func (iku *IdempotencyKeyUpdate) SetKey(s string) *IdempotencyKeyUpdate {
+	iku.mutation.SetKey(s)
+	return iku
+}
+
+// SetCaller sets the "caller" field.
+func (iku *IdempotencyKeyUpdate) SetCaller(s string) *IdempotencyKeyUpdate {
+	iku.mutation.SetCaller(s)
+	return iku
+}
+
+// SetNillableCaller sets the "caller" field if the given value is not nil.
+func (iku *IdempotencyKeyUpdate) SetNillableCaller(s *string) *IdempotencyKeyUpdate {
+	if s != nil {
+		iku.SetCaller(*s)
+	}
+	return iku
+}
+
+// SetMethod sets the "method" field.
+func (iku *IdempotencyKeyUpdate) SetMethod(s string) *IdempotencyKeyUpdate {
+	iku.mutation.SetMethod(s)
+	return iku
+}
+
+// SetRequestHash sets the "request_hash" field.
+func (iku *IdempotencyKeyUpdate) SetRequestHash(s string) *IdempotencyKeyUpdate {
+	iku.mutation.SetRequestHash(s)
+	return iku
+}
+
+// SetState sets the "state" field.
+func (iku *IdempotencyKeyUpdate) SetState(i idempotencykey.State) *IdempotencyKeyUpdate {
+	iku.mutation.SetState(i)
+	return iku
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (iku *IdempotencyKeyUpdate) SetNillableState(i *idempotencykey.State) *IdempotencyKeyUpdate {
+	if i != nil {
+		iku.SetState(*i)
+	}
+	return iku
+}
+
+// SetResponse sets the "response" field.
+func (iku *IdempotencyKeyUpdate) SetResponse(b []byte) *IdempotencyKeyUpdate {
+	iku.mutation.SetResponse(b)
+	return iku
+}
+
+// ClearResponse clears the value of the "response" field.
+func (iku *IdempotencyKeyUpdate) ClearResponse() *IdempotencyKeyUpdate {
+	iku.mutation.ClearResponse()
+	return iku
+}
+
+// SetStatus sets the "status" field.
+func (iku *IdempotencyKeyUpdate) SetStatus(b []byte) *IdempotencyKeyUpdate {
+	iku.mutation.SetStatus(b)
+	return iku
+}
+
+// ClearStatus clears the value of the "status" field.
+func (iku *IdempotencyKeyUpdate) ClearStatus() *IdempotencyKeyUpdate {
+	iku.mutation.ClearStatus()
+	return iku
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (iku *IdempotencyKeyUpdate) SetClaimedAt(t time.Time) *IdempotencyKeyUpdate {
+	iku.mutation.SetClaimedAt(t)
+	return iku
+}
+
+// SetNillableClaimedAt sets the "claimed_at" field if the given value is not nil.
+func (iku *IdempotencyKeyUpdate) SetNillableClaimedAt(t *time.Time) *IdempotencyKeyUpdate {
+	if t != nil {
+		iku.SetClaimedAt(*t)
+	}
+	return iku
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (iku *IdempotencyKeyUpdate) SetExpiresAt(t time.Time) *IdempotencyKeyUpdate {
+	iku.mutation.SetExpiresAt(t)
+	return iku
+}
+
+// Mutation returns the IdempotencyKeyMutation object of the builder.
+func (iku *IdempotencyKeyUpdate) Mutation() *IdempotencyKeyMutation {
+	return iku.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iku *IdempotencyKeyUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(iku.hooks) == 0 {
+		if err = iku.check(); err != nil {
+			return 0, err
+		}
+		affected, err = iku.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*IdempotencyKeyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = iku.check(); err != nil {
+				return 0, err
+			}
+			iku.mutation = mutation
+			affected, err = iku.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(iku.hooks) - 1; i >= 0; i-- {
+			if iku.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = iku.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, iku.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iku *IdempotencyKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := iku.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iku *IdempotencyKeyUpdate) Exec(ctx context.Context) error {
+	_, err := iku.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iku *IdempotencyKeyUpdate) ExecX(ctx context.Context) {
+	if err := iku.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iku *IdempotencyKeyUpdate) check() error {
+	if v, ok := iku.mutation.Key(); ok {
+		if err := idempotencykey.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "IdempotencyKey.key": %w`, err)}
+		}
+	}
+	if v, ok := iku.mutation.Method(); ok {
+		if err := idempotencykey.MethodValidator(v); err != nil {
+			return &ValidationError{Name: "method", err: fmt.Errorf(`ent: validator failed for field "IdempotencyKey.method": %w`, err)}
+		}
+	}
+	if v, ok := iku.mutation.RequestHash(); ok {
+		if err := idempotencykey.RequestHashValidator(v); err != nil {
+			return &ValidationError{Name: "request_hash", err: fmt.Errorf(`ent: validator failed for field "IdempotencyKey.request_hash": %w`, err)}
+		}
+	}
+	if v, ok := iku.mutation.State(); ok {
+		if err := idempotencykey.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "IdempotencyKey.state": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (iku *IdempotencyKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   idempotencykey.Table,
+			Columns: idempotencykey.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: idempotencykey.FieldID,
+			},
+		},
+	}
+	if ps := iku.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iku.mutation.Key(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: idempotencykey.FieldKey,
+		})
+	}
+	if value, ok := iku.mutation.Caller(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: idempotencykey.FieldCaller,
+		})
+	}
+	if value, ok := iku.mutation.Method(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: idempotencykey.FieldMethod,
+		})
+	}
+	if value, ok := iku.mutation.RequestHash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: idempotencykey.FieldRequestHash,
+		})
+	}
+	if value, ok := iku.mutation.State(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: idempotencykey.FieldState,
+		})
+	}
+	if value, ok := iku.mutation.Response(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: idempotencykey.FieldResponse,
+		})
+	}
+	if iku.mutation.ResponseCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: idempotencykey.FieldResponse,
+		})
+	}
+	if value, ok := iku.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: idempotencykey.FieldStatus,
+		})
+	}
+	if iku.mutation.StatusCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: idempotencykey.FieldStatus,
+		})
+	}
+	if value, ok := iku.mutation.ClaimedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: idempotencykey.FieldClaimedAt,
+		})
+	}
+	if value, ok := iku.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: idempotencykey.FieldExpiresAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iku.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{idempotencykey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// IdempotencyKeyUpdateOne is the builder for updating a single IdempotencyKey entity.
+type IdempotencyKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *IdempotencyKeyMutation
+}
+
+// SetKey sets the "key" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetKey(s string) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetKey(s)
+	return ikuo
+}
+
+// SetCaller sets the "caller" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetCaller(s string) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetCaller(s)
+	return ikuo
+}
+
+// SetNillableCaller sets the "caller" field if the given value is not nil.
+func (ikuo *IdempotencyKeyUpdateOne) SetNillableCaller(s *string) *IdempotencyKeyUpdateOne {
+	if s != nil {
+		ikuo.SetCaller(*s)
+	}
+	return ikuo
+}
+
+// SetMethod sets the "method" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetMethod(s string) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetMethod(s)
+	return ikuo
+}
+
+// SetRequestHash sets the "request_hash" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetRequestHash(s string) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetRequestHash(s)
+	return ikuo
+}
+
+// SetState sets the "state" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetState(i idempotencykey.State) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetState(i)
+	return ikuo
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (ikuo *IdempotencyKeyUpdateOne) SetNillableState(i *idempotencykey.State) *IdempotencyKeyUpdateOne {
+	if i != nil {
+		ikuo.SetState(*i)
+	}
+	return ikuo
+}
+
+// SetResponse sets the "response" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetResponse(b []byte) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetResponse(b)
+	return ikuo
+}
+
+// ClearResponse clears the value of the "response" field.
+func (ikuo *IdempotencyKeyUpdateOne) ClearResponse() *IdempotencyKeyUpdateOne {
+	ikuo.mutation.ClearResponse()
+	return ikuo
+}
+
+// SetStatus sets the "status" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetStatus(b []byte) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetStatus(b)
+	return ikuo
+}
+
+// ClearStatus clears the value of the "status" field.
+func (ikuo *IdempotencyKeyUpdateOne) ClearStatus() *IdempotencyKeyUpdateOne {
+	ikuo.mutation.ClearStatus()
+	return ikuo
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetClaimedAt(t time.Time) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetClaimedAt(t)
+	return ikuo
+}
+
+// SetNillableClaimedAt sets the "claimed_at" field if the given value is not nil.
+func (ikuo *IdempotencyKeyUpdateOne) SetNillableClaimedAt(t *time.Time) *IdempotencyKeyUpdateOne {
+	if t != nil {
+		ikuo.SetClaimedAt(*t)
+	}
+	return ikuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetExpiresAt(t time.Time) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetExpiresAt(t)
+	return ikuo
+}
+
+// Mutation returns the IdempotencyKeyMutation object of the builder.
+func (ikuo *IdempotencyKeyUpdateOne) Mutation() *IdempotencyKeyMutation {
+	return ikuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ikuo *IdempotencyKeyUpdateOne) Select(field string, fields ...string) *IdempotencyKeyUpdateOne {
+	ikuo.fields = append([]string{field}, fields...)
+	return ikuo
+}
+
+// Save executes the query and returns the updated IdempotencyKey entity.
+func (ikuo *IdempotencyKeyUpdateOne) Save(ctx context.Context) (*IdempotencyKey, error) {
+	var (
+		err  error
+		node *IdempotencyKey
+	)
+	if len(ikuo.hooks) == 0 {
+		if err = ikuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = ikuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*IdempotencyKeyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ikuo.check(); err != nil {
+				return nil, err
+			}
+			ikuo.mutation = mutation
+			node, err = ikuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ikuo.hooks) - 1; i >= 0; i-- {
+			if ikuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ikuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ikuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ikuo *IdempotencyKeyUpdateOne) SaveX(ctx context.Context) *IdempotencyKey {
+	node, err := ikuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ikuo *IdempotencyKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := ikuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ikuo *IdempotencyKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := ikuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ikuo *IdempotencyKeyUpdateOne) check() error {
+	if v, ok := ikuo.mutation.Key(); ok {
+		if err := idempotencykey.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "IdempotencyKey.key": %w`, err)}
+		}
+	}
+	if v, ok := ikuo.mutation.Method(); ok {
+		if err := idempotencykey.MethodValidator(v); err != nil {
+			return &ValidationError{Name: "method", err: fmt.Errorf(`ent: validator failed for field "IdempotencyKey.method": %w`, err)}
+		}
+	}
+	if v, ok := ikuo.mutation.RequestHash(); ok {
+		if err := idempotencykey.RequestHashValidator(v); err != nil {
+			return &ValidationError{Name: "request_hash", err: fmt.Errorf(`ent: validator failed for field "IdempotencyKey.request_hash": %w`, err)}
+		}
+	}
+	if v, ok := ikuo.mutation.State(); ok {
+		if err := idempotencykey.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "IdempotencyKey.state": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ikuo *IdempotencyKeyUpdateOne) sqlSave(ctx context.Context) (_node *IdempotencyKey, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   idempotencykey.Table,
+			Columns: idempotencykey.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: idempotencykey.FieldID,
+			},
+		},
+	}
+	id, ok := ikuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "IdempotencyKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ikuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, idempotencykey.FieldID)
+		for _, f := range fields {
+			if !idempotencykey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != idempotencykey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ikuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ikuo.mutation.Key(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: idempotencykey.FieldKey,
+		})
+	}
+	if value, ok := ikuo.mutation.Caller(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: idempotencykey.FieldCaller,
+		})
+	}
+	if value, ok := ikuo.mutation.Method(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: idempotencykey.FieldMethod,
+		})
+	}
+	if value, ok := ikuo.mutation.RequestHash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: idempotencykey.FieldRequestHash,
+		})
+	}
+	if value, ok := ikuo.mutation.State(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: idempotencykey.FieldState,
+		})
+	}
+	if value, ok := ikuo.mutation.Response(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: idempotencykey.FieldResponse,
+		})
+	}
+	if ikuo.mutation.ResponseCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: idempotencykey.FieldResponse,
+		})
+	}
+	if value, ok := ikuo.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: idempotencykey.FieldStatus,
+		})
+	}
+	if ikuo.mutation.StatusCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: idempotencykey.FieldStatus,
+		})
+	}
+	if value, ok := ikuo.mutation.ClaimedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: idempotencykey.FieldClaimedAt,
+		})
+	}
+	if value, ok := ikuo.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: idempotencykey.FieldExpiresAt,
+		})
+	}
+	_node = &IdempotencyKey{config: ikuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ikuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{idempotencykey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -8,6 +8,38 @@ import (
 )
 
 var (
+	// IdempotencyKeysColumns holds the columns for the "idempotency_keys" table.
+	IdempotencyKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key", Type: field.TypeString},
+		{Name: "caller", Type: field.TypeString, Default: ""},
+		{Name: "method", Type: field.TypeString},
+		{Name: "request_hash", Type: field.TypeString},
+		{Name: "state", Type: field.TypeEnum, Enums: []string{"pending", "completed"}, Default: "pending"},
+		{Name: "response", Type: field.TypeBytes, Nullable: true},
+		{Name: "status", Type: field.TypeBytes, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "claimed_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// IdempotencyKeysTable holds the schema information for the "idempotency_keys" table.
+	IdempotencyKeysTable = &schema.Table{
+		Name:       "idempotency_keys",
+		Columns:    IdempotencyKeysColumns,
+		PrimaryKey: []*schema.Column{IdempotencyKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idempotencykey_caller_method_key",
+				Unique:  true,
+				Columns: []*schema.Column{IdempotencyKeysColumns[2], IdempotencyKeysColumns[3], IdempotencyKeysColumns[1]},
+			},
+			{
+				Name:    "idempotencykey_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{IdempotencyKeysColumns[10]},
+			},
+		},
+	}
 	// ProductsColumns holds the columns for the "products" table.
 	ProductsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -16,6 +48,7 @@ var (
 		{Name: "price", Type: field.TypeInt},
 		{Name: "stock", Type: field.TypeInt},
 		{Name: "image", Type: field.TypeString, Nullable: true},
+		{Name: "image_variants", Type: field.TypeJSON, Nullable: true},
 		{Name: "video", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
@@ -26,11 +59,120 @@ var (
 		Columns:    ProductsColumns,
 		PrimaryKey: []*schema.Column{ProductsColumns[0]},
 	}
+	// ProductMediaColumns holds the columns for the "product_media" table.
+	ProductMediaColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"image", "video"}},
+		{Name: "url", Type: field.TypeString},
+		{Name: "variants", Type: field.TypeJSON, Nullable: true},
+		{Name: "alt_text", Type: field.TypeString, Nullable: true},
+		{Name: "position", Type: field.TypeInt},
+		{Name: "is_primary", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "product_id", Type: field.TypeInt},
+	}
+	// ProductMediaTable holds the schema information for the "product_media" table.
+	ProductMediaTable = &schema.Table{
+		Name:       "product_media",
+		Columns:    ProductMediaColumns,
+		PrimaryKey: []*schema.Column{ProductMediaColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "product_media_products_media",
+				Columns:    []*schema.Column{ProductMediaColumns[8]},
+				RefColumns: []*schema.Column{ProductsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "productmedia_product_id_position",
+				Unique:  false,
+				Columns: []*schema.Column{ProductMediaColumns[8], ProductMediaColumns[5]},
+			},
+		},
+	}
+	// ReservationsColumns holds the columns for the "reservations" table.
+	ReservationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "quantity", Type: field.TypeInt},
+		{Name: "owner", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "confirmed", "cancelled", "expired"}, Default: "active"},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "product_id", Type: field.TypeInt},
+	}
+	// ReservationsTable holds the schema information for the "reservations" table.
+	ReservationsTable = &schema.Table{
+		Name:       "reservations",
+		Columns:    ReservationsColumns,
+		PrimaryKey: []*schema.Column{ReservationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "reservations_products_reservations",
+				Columns:    []*schema.Column{ReservationsColumns[7]},
+				RefColumns: []*schema.Column{ProductsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "reservation_product_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{ReservationsColumns[7], ReservationsColumns[3]},
+			},
+			{
+				Name:    "reservation_status_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReservationsColumns[3], ReservationsColumns[4]},
+			},
+		},
+	}
+	// StockMovementsColumns holds the columns for the "stock_movements" table.
+	StockMovementsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "delta", Type: field.TypeInt},
+		{Name: "stock_after", Type: field.TypeInt},
+		{Name: "reason", Type: field.TypeEnum, Enums: []string{"initial", "sale", "restock", "return", "cancellation", "adjustment"}},
+		{Name: "actor", Type: field.TypeString, Nullable: true},
+		{Name: "reference_id", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "product_id", Type: field.TypeInt, Nullable: true},
+	}
+	// StockMovementsTable holds the schema information for the "stock_movements" table.
+	StockMovementsTable = &schema.Table{
+		Name:       "stock_movements",
+		Columns:    StockMovementsColumns,
+		PrimaryKey: []*schema.Column{StockMovementsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "stock_movements_products_movements",
+				Columns:    []*schema.Column{StockMovementsColumns[7]},
+				RefColumns: []*schema.Column{ProductsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "stockmovement_product_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{StockMovementsColumns[7], StockMovementsColumns[6]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		IdempotencyKeysTable,
 		ProductsTable,
+		ProductMediaTable,
+		ReservationsTable,
+		StockMovementsTable,
 	}
 )
 
 func init() {
+	ProductMediaTable.ForeignKeys[0].RefTable = ProductsTable
+	ReservationsTable.ForeignKeys[0].RefTable = ProductsTable
+	StockMovementsTable.ForeignKeys[0].RefTable = ProductsTable
 }
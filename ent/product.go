@@ -3,12 +3,14 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/law-a-1/product-service/ent/product"
+	"github.com/law-a-1/product-service/ent/schema"
 )
 
 // Product is the model entity for the Product schema.
@@ -26,12 +28,57 @@ type Product struct {
 	Stock int `json:"stock,omitempty"`
 	// Image holds the value of the "image" field.
 	Image string `json:"image,omitempty"`
+	// ImageVariants holds the value of the "image_variants" field.
+	ImageVariants map[string]schema.ImageVariant `json:"image_variants,omitempty"`
 	// Video holds the value of the "video" field.
 	Video string `json:"video,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProductQuery when eager-loading is set.
+	Edges ProductEdges `json:"edges"`
+}
+
+// ProductEdges holds the relations/edges for other nodes in the graph.
+type ProductEdges struct {
+	// Reservations holds the value of the reservations edge.
+	Reservations []*Reservation `json:"reservations,omitempty"`
+	// Movements holds the value of the movements edge.
+	Movements []*StockMovement `json:"movements,omitempty"`
+	// Media holds the value of the media edge.
+	Media []*ProductMedia `json:"media,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// ReservationsOrErr returns the Reservations value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProductEdges) ReservationsOrErr() ([]*Reservation, error) {
+	if e.loadedTypes[0] {
+		return e.Reservations, nil
+	}
+	return nil, &NotLoadedError{edge: "reservations"}
+}
+
+// MovementsOrErr returns the Movements value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProductEdges) MovementsOrErr() ([]*StockMovement, error) {
+	if e.loadedTypes[1] {
+		return e.Movements, nil
+	}
+	return nil, &NotLoadedError{edge: "movements"}
+}
+
+// MediaOrErr returns the Media value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProductEdges) MediaOrErr() ([]*ProductMedia, error) {
+	if e.loadedTypes[2] {
+		return e.Media, nil
+	}
+	return nil, &NotLoadedError{edge: "media"}
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -39,6 +86,8 @@ func (*Product) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case product.FieldImageVariants:
+			values[i] = new([]byte)
 		case product.FieldID, product.FieldPrice, product.FieldStock:
 			values[i] = new(sql.NullInt64)
 		case product.FieldName, product.FieldDescription, product.FieldImage, product.FieldVideo:
@@ -96,6 +145,14 @@ func (pr *Product) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				pr.Image = value.String
 			}
+		case product.FieldImageVariants:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field image_variants", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pr.ImageVariants); err != nil {
+					return fmt.Errorf("unmarshal field image_variants: %w", err)
+				}
+			}
 		case product.FieldVideo:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field video", values[i])
@@ -119,6 +176,21 @@ func (pr *Product) assignValues(columns []string, values []interface{}) error {
 	return nil
 }
 
+// QueryReservations queries the "reservations" edge of the Product entity.
+func (pr *Product) QueryReservations() *ReservationQuery {
+	return (&ProductClient{config: pr.config}).QueryReservations(pr)
+}
+
+// QueryMovements queries the "movements" edge of the Product entity.
+func (pr *Product) QueryMovements() *StockMovementQuery {
+	return (&ProductClient{config: pr.config}).QueryMovements(pr)
+}
+
+// QueryMedia queries the "media" edge of the Product entity.
+func (pr *Product) QueryMedia() *ProductMediaQuery {
+	return (&ProductClient{config: pr.config}).QueryMedia(pr)
+}
+
 // Update returns a builder for updating this Product.
 // Note that you need to call Product.Unwrap() before calling this method if this Product
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -152,6 +224,8 @@ func (pr *Product) String() string {
 	builder.WriteString(fmt.Sprintf("%v", pr.Stock))
 	builder.WriteString(", image=")
 	builder.WriteString(pr.Image)
+	builder.WriteString(", image_variants=")
+	builder.WriteString(fmt.Sprintf("%v", pr.ImageVariants))
 	builder.WriteString(", video=")
 	builder.WriteString(pr.Video)
 	builder.WriteString(", created_at=")
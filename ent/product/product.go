@@ -21,14 +21,43 @@ const (
 	FieldStock = "stock"
 	// FieldImage holds the string denoting the image field in the database.
 	FieldImage = "image"
+	// FieldImageVariants holds the string denoting the image_variants field in the database.
+	FieldImageVariants = "image_variants"
 	// FieldVideo holds the string denoting the video field in the database.
 	FieldVideo = "video"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// EdgeReservations holds the string denoting the reservations edge name in mutations.
+	EdgeReservations = "reservations"
+	// EdgeMovements holds the string denoting the movements edge name in mutations.
+	EdgeMovements = "movements"
+	// EdgeMedia holds the string denoting the media edge name in mutations.
+	EdgeMedia = "media"
 	// Table holds the table name of the product in the database.
 	Table = "products"
+	// ReservationsTable is the table that holds the reservations relation/edge.
+	ReservationsTable = "reservations"
+	// ReservationsInverseTable is the table name for the Reservation entity.
+	// It exists in this package in order to avoid circular dependency with the "reservation" package.
+	ReservationsInverseTable = "reservations"
+	// ReservationsColumn is the table column denoting the reservations relation/edge.
+	ReservationsColumn = "product_id"
+	// MovementsTable is the table that holds the movements relation/edge.
+	MovementsTable = "stock_movements"
+	// MovementsInverseTable is the table name for the StockMovement entity.
+	// It exists in this package in order to avoid circular dependency with the "stockmovement" package.
+	MovementsInverseTable = "stock_movements"
+	// MovementsColumn is the table column denoting the movements relation/edge.
+	MovementsColumn = "product_id"
+	// MediaTable is the table that holds the media relation/edge.
+	MediaTable = "product_media"
+	// MediaInverseTable is the table name for the ProductMedia entity.
+	// It exists in this package in order to avoid circular dependency with the "productmedia" package.
+	MediaInverseTable = "product_media"
+	// MediaColumn is the table column denoting the media relation/edge.
+	MediaColumn = "product_id"
 )
 
 // Columns holds all SQL columns for product fields.
@@ -39,6 +68,7 @@ var Columns = []string{
 	FieldPrice,
 	FieldStock,
 	FieldImage,
+	FieldImageVariants,
 	FieldVideo,
 	FieldCreatedAt,
 	FieldUpdatedAt,
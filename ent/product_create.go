@@ -11,6 +11,10 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/law-a-1/product-service/ent/product"
+	"github.com/law-a-1/product-service/ent/productmedia"
+	"github.com/law-a-1/product-service/ent/reservation"
+	"github.com/law-a-1/product-service/ent/schema"
+	"github.com/law-a-1/product-service/ent/stockmovement"
 )
 
 // ProductCreate is the builder for creating a Product entity.
@@ -58,6 +62,12 @@ func (pc *ProductCreate) SetNillableImage(s *string) *ProductCreate {
 	return pc
 }
 
+// SetImageVariants sets the "image_variants" field.
+func (pc *ProductCreate) SetImageVariants(mv map[string]schema.ImageVariant) *ProductCreate {
+	pc.mutation.SetImageVariants(mv)
+	return pc
+}
+
 // SetVideo sets the "video" field.
 func (pc *ProductCreate) SetVideo(s string) *ProductCreate {
 	pc.mutation.SetVideo(s)
@@ -100,6 +110,51 @@ func (pc *ProductCreate) SetNillableUpdatedAt(t *time.Time) *ProductCreate {
 	return pc
 }
 
+// AddReservationIDs adds the "reservations" edge to the Reservation entity by IDs.
+func (pc *ProductCreate) AddReservationIDs(ids ...int) *ProductCreate {
+	pc.mutation.AddReservationIDs(ids...)
+	return pc
+}
+
+// AddReservations adds the "reservations" edges to the Reservation entity.
+func (pc *ProductCreate) AddReservations(r ...*Reservation) *ProductCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pc.AddReservationIDs(ids...)
+}
+
+// AddMovementIDs adds the "movements" edge to the StockMovement entity by IDs.
+func (pc *ProductCreate) AddMovementIDs(ids ...int) *ProductCreate {
+	pc.mutation.AddMovementIDs(ids...)
+	return pc
+}
+
+// AddMovements adds the "movements" edges to the StockMovement entity.
+func (pc *ProductCreate) AddMovements(s ...*StockMovement) *ProductCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return pc.AddMovementIDs(ids...)
+}
+
+// AddMediumIDs adds the "media" edge to the ProductMedia entity by IDs.
+func (pc *ProductCreate) AddMediumIDs(ids ...int) *ProductCreate {
+	pc.mutation.AddMediumIDs(ids...)
+	return pc
+}
+
+// AddMedia adds the "media" edges to the ProductMedia entity.
+func (pc *ProductCreate) AddMedia(p ...*ProductMedia) *ProductCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddMediumIDs(ids...)
+}
+
 // Mutation returns the ProductMutation object of the builder.
 func (pc *ProductCreate) Mutation() *ProductMutation {
 	return pc.mutation
@@ -288,6 +343,14 @@ func (pc *ProductCreate) createSpec() (*Product, *sqlgraph.CreateSpec) {
 		})
 		_node.Image = value
 	}
+	if value, ok := pc.mutation.ImageVariants(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: product.FieldImageVariants,
+		})
+		_node.ImageVariants = value
+	}
 	if value, ok := pc.mutation.Video(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -312,6 +375,63 @@ func (pc *ProductCreate) createSpec() (*Product, *sqlgraph.CreateSpec) {
 		})
 		_node.UpdatedAt = value
 	}
+	if nodes := pc.mutation.ReservationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.ReservationsTable,
+			Columns: []string{product.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: reservation.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.MovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.MovementsTable,
+			Columns: []string{product.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: stockmovement.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.MediaTable,
+			Columns: []string{product.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: productmedia.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
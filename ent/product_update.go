@@ -13,6 +13,10 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/law-a-1/product-service/ent/predicate"
 	"github.com/law-a-1/product-service/ent/product"
+	"github.com/law-a-1/product-service/ent/productmedia"
+	"github.com/law-a-1/product-service/ent/reservation"
+	"github.com/law-a-1/product-service/ent/schema"
+	"github.com/law-a-1/product-service/ent/stockmovement"
 )
 
 // ProductUpdate is the builder for updating Product entities.
@@ -86,6 +90,18 @@ func (pu *ProductUpdate) ClearImage() *ProductUpdate {
 	return pu
 }
 
+// SetImageVariants sets the "image_variants" field.
+func (pu *ProductUpdate) SetImageVariants(mv map[string]schema.ImageVariant) *ProductUpdate {
+	pu.mutation.SetImageVariants(mv)
+	return pu
+}
+
+// ClearImageVariants clears the value of the "image_variants" field.
+func (pu *ProductUpdate) ClearImageVariants() *ProductUpdate {
+	pu.mutation.ClearImageVariants()
+	return pu
+}
+
 // SetVideo sets the "video" field.
 func (pu *ProductUpdate) SetVideo(s string) *ProductUpdate {
 	pu.mutation.SetVideo(s)
@@ -120,11 +136,119 @@ func (pu *ProductUpdate) SetNillableUpdatedAt(t *time.Time) *ProductUpdate {
 	return pu
 }
 
+// AddReservationIDs adds the "reservations" edge to the Reservation entity by IDs.
+func (pu *ProductUpdate) AddReservationIDs(ids ...int) *ProductUpdate {
+	pu.mutation.AddReservationIDs(ids...)
+	return pu
+}
+
+// AddReservations adds the "reservations" edges to the Reservation entity.
+func (pu *ProductUpdate) AddReservations(r ...*Reservation) *ProductUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pu.AddReservationIDs(ids...)
+}
+
+// AddMovementIDs adds the "movements" edge to the StockMovement entity by IDs.
+func (pu *ProductUpdate) AddMovementIDs(ids ...int) *ProductUpdate {
+	pu.mutation.AddMovementIDs(ids...)
+	return pu
+}
+
+// AddMovements adds the "movements" edges to the StockMovement entity.
+func (pu *ProductUpdate) AddMovements(s ...*StockMovement) *ProductUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return pu.AddMovementIDs(ids...)
+}
+
+// AddMediumIDs adds the "media" edge to the ProductMedia entity by IDs.
+func (pu *ProductUpdate) AddMediumIDs(ids ...int) *ProductUpdate {
+	pu.mutation.AddMediumIDs(ids...)
+	return pu
+}
+
+// AddMedia adds the "media" edges to the ProductMedia entity.
+func (pu *ProductUpdate) AddMedia(p ...*ProductMedia) *ProductUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddMediumIDs(ids...)
+}
+
 // Mutation returns the ProductMutation object of the builder.
 func (pu *ProductUpdate) Mutation() *ProductMutation {
 	return pu.mutation
 }
 
+// ClearReservations clears all "reservations" edges to the Reservation entity.
+func (pu *ProductUpdate) ClearReservations() *ProductUpdate {
+	pu.mutation.ClearReservations()
+	return pu
+}
+
+// RemoveReservationIDs removes the "reservations" edge to Reservation entities by IDs.
+func (pu *ProductUpdate) RemoveReservationIDs(ids ...int) *ProductUpdate {
+	pu.mutation.RemoveReservationIDs(ids...)
+	return pu
+}
+
+// RemoveReservations removes "reservations" edges to Reservation entities.
+func (pu *ProductUpdate) RemoveReservations(r ...*Reservation) *ProductUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pu.RemoveReservationIDs(ids...)
+}
+
+// ClearMovements clears all "movements" edges to the StockMovement entity.
+func (pu *ProductUpdate) ClearMovements() *ProductUpdate {
+	pu.mutation.ClearMovements()
+	return pu
+}
+
+// RemoveMovementIDs removes the "movements" edge to StockMovement entities by IDs.
+func (pu *ProductUpdate) RemoveMovementIDs(ids ...int) *ProductUpdate {
+	pu.mutation.RemoveMovementIDs(ids...)
+	return pu
+}
+
+// RemoveMovements removes "movements" edges to StockMovement entities.
+func (pu *ProductUpdate) RemoveMovements(s ...*StockMovement) *ProductUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return pu.RemoveMovementIDs(ids...)
+}
+
+// ClearMedia clears all "media" edges to the ProductMedia entity.
+func (pu *ProductUpdate) ClearMedia() *ProductUpdate {
+	pu.mutation.ClearMedia()
+	return pu
+}
+
+// RemoveMediumIDs removes the "media" edge to ProductMedia entities by IDs.
+func (pu *ProductUpdate) RemoveMediumIDs(ids ...int) *ProductUpdate {
+	pu.mutation.RemoveMediumIDs(ids...)
+	return pu
+}
+
+// RemoveMedia removes "media" edges to ProductMedia entities.
+func (pu *ProductUpdate) RemoveMedia(p ...*ProductMedia) *ProductUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemoveMediumIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (pu *ProductUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -283,6 +407,19 @@ func (pu *ProductUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: product.FieldImage,
 		})
 	}
+	if value, ok := pu.mutation.ImageVariants(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: product.FieldImageVariants,
+		})
+	}
+	if pu.mutation.ImageVariantsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: product.FieldImageVariants,
+		})
+	}
 	if value, ok := pu.mutation.Video(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -303,6 +440,168 @@ func (pu *ProductUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: product.FieldUpdatedAt,
 		})
 	}
+	if pu.mutation.ReservationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.ReservationsTable,
+			Columns: []string{product.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: reservation.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedReservationsIDs(); len(nodes) > 0 && !pu.mutation.ReservationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.ReservationsTable,
+			Columns: []string{product.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: reservation.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.ReservationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.ReservationsTable,
+			Columns: []string{product.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: reservation.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.MovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.MovementsTable,
+			Columns: []string{product.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: stockmovement.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedMovementsIDs(); len(nodes) > 0 && !pu.mutation.MovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.MovementsTable,
+			Columns: []string{product.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: stockmovement.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.MovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.MovementsTable,
+			Columns: []string{product.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: stockmovement.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.MediaTable,
+			Columns: []string{product.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: productmedia.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedMediaIDs(); len(nodes) > 0 && !pu.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.MediaTable,
+			Columns: []string{product.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: productmedia.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.MediaTable,
+			Columns: []string{product.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: productmedia.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{product.Label}
@@ -380,6 +679,18 @@ func (puo *ProductUpdateOne) ClearImage() *ProductUpdateOne {
 	return puo
 }
 
+// SetImageVariants sets the "image_variants" field.
+func (puo *ProductUpdateOne) SetImageVariants(mv map[string]schema.ImageVariant) *ProductUpdateOne {
+	puo.mutation.SetImageVariants(mv)
+	return puo
+}
+
+// ClearImageVariants clears the value of the "image_variants" field.
+func (puo *ProductUpdateOne) ClearImageVariants() *ProductUpdateOne {
+	puo.mutation.ClearImageVariants()
+	return puo
+}
+
 // SetVideo sets the "video" field.
 func (puo *ProductUpdateOne) SetVideo(s string) *ProductUpdateOne {
 	puo.mutation.SetVideo(s)
@@ -414,11 +725,119 @@ func (puo *ProductUpdateOne) SetNillableUpdatedAt(t *time.Time) *ProductUpdateOn
 	return puo
 }
 
+// AddReservationIDs adds the "reservations" edge to the Reservation entity by IDs.
+func (puo *ProductUpdateOne) AddReservationIDs(ids ...int) *ProductUpdateOne {
+	puo.mutation.AddReservationIDs(ids...)
+	return puo
+}
+
+// AddReservations adds the "reservations" edges to the Reservation entity.
+func (puo *ProductUpdateOne) AddReservations(r ...*Reservation) *ProductUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return puo.AddReservationIDs(ids...)
+}
+
+// AddMovementIDs adds the "movements" edge to the StockMovement entity by IDs.
+func (puo *ProductUpdateOne) AddMovementIDs(ids ...int) *ProductUpdateOne {
+	puo.mutation.AddMovementIDs(ids...)
+	return puo
+}
+
+// AddMovements adds the "movements" edges to the StockMovement entity.
+func (puo *ProductUpdateOne) AddMovements(s ...*StockMovement) *ProductUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return puo.AddMovementIDs(ids...)
+}
+
+// AddMediumIDs adds the "media" edge to the ProductMedia entity by IDs.
+func (puo *ProductUpdateOne) AddMediumIDs(ids ...int) *ProductUpdateOne {
+	puo.mutation.AddMediumIDs(ids...)
+	return puo
+}
+
+// AddMedia adds the "media" edges to the ProductMedia entity.
+func (puo *ProductUpdateOne) AddMedia(p ...*ProductMedia) *ProductUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddMediumIDs(ids...)
+}
+
 // Mutation returns the ProductMutation object of the builder.
 func (puo *ProductUpdateOne) Mutation() *ProductMutation {
 	return puo.mutation
 }
 
+// ClearReservations clears all "reservations" edges to the Reservation entity.
+func (puo *ProductUpdateOne) ClearReservations() *ProductUpdateOne {
+	puo.mutation.ClearReservations()
+	return puo
+}
+
+// RemoveReservationIDs removes the "reservations" edge to Reservation entities by IDs.
+func (puo *ProductUpdateOne) RemoveReservationIDs(ids ...int) *ProductUpdateOne {
+	puo.mutation.RemoveReservationIDs(ids...)
+	return puo
+}
+
+// RemoveReservations removes "reservations" edges to Reservation entities.
+func (puo *ProductUpdateOne) RemoveReservations(r ...*Reservation) *ProductUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return puo.RemoveReservationIDs(ids...)
+}
+
+// ClearMovements clears all "movements" edges to the StockMovement entity.
+func (puo *ProductUpdateOne) ClearMovements() *ProductUpdateOne {
+	puo.mutation.ClearMovements()
+	return puo
+}
+
+// RemoveMovementIDs removes the "movements" edge to StockMovement entities by IDs.
+func (puo *ProductUpdateOne) RemoveMovementIDs(ids ...int) *ProductUpdateOne {
+	puo.mutation.RemoveMovementIDs(ids...)
+	return puo
+}
+
+// RemoveMovements removes "movements" edges to StockMovement entities.
+func (puo *ProductUpdateOne) RemoveMovements(s ...*StockMovement) *ProductUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return puo.RemoveMovementIDs(ids...)
+}
+
+// ClearMedia clears all "media" edges to the ProductMedia entity.
+func (puo *ProductUpdateOne) ClearMedia() *ProductUpdateOne {
+	puo.mutation.ClearMedia()
+	return puo
+}
+
+// RemoveMediumIDs removes the "media" edge to ProductMedia entities by IDs.
+func (puo *ProductUpdateOne) RemoveMediumIDs(ids ...int) *ProductUpdateOne {
+	puo.mutation.RemoveMediumIDs(ids...)
+	return puo
+}
+
+// RemoveMedia removes "media" edges to ProductMedia entities.
+func (puo *ProductUpdateOne) RemoveMedia(p ...*ProductMedia) *ProductUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.RemoveMediumIDs(ids...)
+}
+
 // Select allows selecting one or more fields (columns) of the returned entity.
 // The default is selecting all fields defined in the entity schema.
 func (puo *ProductUpdateOne) Select(field string, fields ...string) *ProductUpdateOne {
@@ -601,6 +1020,19 @@ func (puo *ProductUpdateOne) sqlSave(ctx context.Context) (_node *Product, err e
 			Column: product.FieldImage,
 		})
 	}
+	if value, ok := puo.mutation.ImageVariants(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: product.FieldImageVariants,
+		})
+	}
+	if puo.mutation.ImageVariantsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: product.FieldImageVariants,
+		})
+	}
 	if value, ok := puo.mutation.Video(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -621,6 +1053,168 @@ func (puo *ProductUpdateOne) sqlSave(ctx context.Context) (_node *Product, err e
 			Column: product.FieldUpdatedAt,
 		})
 	}
+	if puo.mutation.ReservationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.ReservationsTable,
+			Columns: []string{product.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: reservation.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedReservationsIDs(); len(nodes) > 0 && !puo.mutation.ReservationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.ReservationsTable,
+			Columns: []string{product.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: reservation.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.ReservationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.ReservationsTable,
+			Columns: []string{product.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: reservation.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.MovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.MovementsTable,
+			Columns: []string{product.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: stockmovement.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedMovementsIDs(); len(nodes) > 0 && !puo.mutation.MovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.MovementsTable,
+			Columns: []string{product.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: stockmovement.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.MovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.MovementsTable,
+			Columns: []string{product.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: stockmovement.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.MediaTable,
+			Columns: []string{product.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: productmedia.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedMediaIDs(); len(nodes) > 0 && !puo.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.MediaTable,
+			Columns: []string{product.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: productmedia.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.MediaTable,
+			Columns: []string{product.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: productmedia.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Product{config: puo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,212 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/law-a-1/product-service/ent/product"
+	"github.com/law-a-1/product-service/ent/productmedia"
+	"github.com/law-a-1/product-service/ent/schema"
+)
+
+// ProductMedia is the model entity for the ProductMedia schema.
+type ProductMedia struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ProductID holds the value of the "product_id" field.
+	ProductID int `json:"product_id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind productmedia.Kind `json:"kind,omitempty"`
+	// URL holds the value of the "url" field.
+	URL string `json:"url,omitempty"`
+	// Variants holds the value of the "variants" field.
+	Variants map[string]schema.ImageVariant `json:"variants,omitempty"`
+	// AltText holds the value of the "alt_text" field.
+	AltText string `json:"alt_text,omitempty"`
+	// Position holds the value of the "position" field.
+	Position int `json:"position,omitempty"`
+	// IsPrimary holds the value of the "is_primary" field.
+	IsPrimary bool `json:"is_primary,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProductMediaQuery when eager-loading is set.
+	Edges ProductMediaEdges `json:"edges"`
+}
+
+// ProductMediaEdges holds the relations/edges for other nodes in the graph.
+type ProductMediaEdges struct {
+	// Product holds the value of the product edge.
+	Product *Product `json:"product,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProductOrErr returns the Product value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProductMediaEdges) ProductOrErr() (*Product, error) {
+	if e.loadedTypes[0] {
+		if e.Product == nil {
+			// The edge product was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: product.Label}
+		}
+		return e.Product, nil
+	}
+	return nil, &NotLoadedError{edge: "product"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProductMedia) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case productmedia.FieldVariants:
+			values[i] = new([]byte)
+		case productmedia.FieldIsPrimary:
+			values[i] = new(sql.NullBool)
+		case productmedia.FieldID, productmedia.FieldProductID, productmedia.FieldPosition:
+			values[i] = new(sql.NullInt64)
+		case productmedia.FieldKind, productmedia.FieldURL, productmedia.FieldAltText:
+			values[i] = new(sql.NullString)
+		case productmedia.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type ProductMedia", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProductMedia fields.
+func (pm *ProductMedia) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case productmedia.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pm.ID = int(value.Int64)
+		case productmedia.FieldProductID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value.Valid {
+				pm.ProductID = int(value.Int64)
+			}
+		case productmedia.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				pm.Kind = productmedia.Kind(value.String)
+			}
+		case productmedia.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				pm.URL = value.String
+			}
+		case productmedia.FieldVariants:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field variants", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pm.Variants); err != nil {
+					return fmt.Errorf("unmarshal field variants: %w", err)
+				}
+			}
+		case productmedia.FieldAltText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field alt_text", values[i])
+			} else if value.Valid {
+				pm.AltText = value.String
+			}
+		case productmedia.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				pm.Position = int(value.Int64)
+			}
+		case productmedia.FieldIsPrimary:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_primary", values[i])
+			} else if value.Valid {
+				pm.IsPrimary = value.Bool
+			}
+		case productmedia.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pm.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryProduct queries the "product" edge of the ProductMedia entity.
+func (pm *ProductMedia) QueryProduct() *ProductQuery {
+	return (&ProductMediaClient{config: pm.config}).QueryProduct(pm)
+}
+
+// Update returns a builder for updating this ProductMedia.
+// Note that you need to call ProductMedia.Unwrap() before calling this method if this ProductMedia
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pm *ProductMedia) Update() *ProductMediaUpdateOne {
+	return (&ProductMediaClient{config: pm.config}).UpdateOne(pm)
+}
+
+// Unwrap unwraps the ProductMedia entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pm *ProductMedia) Unwrap() *ProductMedia {
+	tx, ok := pm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProductMedia is not a transactional entity")
+	}
+	pm.config.driver = tx.drv
+	return pm
+}
+
+// String implements the fmt.Stringer.
+func (pm *ProductMedia) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProductMedia(")
+	builder.WriteString(fmt.Sprintf("id=%v", pm.ID))
+	builder.WriteString(", product_id=")
+	builder.WriteString(fmt.Sprintf("%v", pm.ProductID))
+	builder.WriteString(", kind=")
+	builder.WriteString(fmt.Sprintf("%v", pm.Kind))
+	builder.WriteString(", url=")
+	builder.WriteString(pm.URL)
+	builder.WriteString(", variants=")
+	builder.WriteString(fmt.Sprintf("%v", pm.Variants))
+	builder.WriteString(", alt_text=")
+	builder.WriteString(pm.AltText)
+	builder.WriteString(", position=")
+	builder.WriteString(fmt.Sprintf("%v", pm.Position))
+	builder.WriteString(", is_primary=")
+	builder.WriteString(fmt.Sprintf("%v", pm.IsPrimary))
+	builder.WriteString(", created_at=")
+	builder.WriteString(pm.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProductMediaSlice is a parsable slice of ProductMedia.
+type ProductMediaSlice []*ProductMedia
+
+func (pm ProductMediaSlice) config(cfg config) {
+	for _i := range pm {
+		pm[_i].config = cfg
+	}
+}
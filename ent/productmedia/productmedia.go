@@ -0,0 +1,99 @@
+// Code generated by entc, DO NOT EDIT.
+
+package productmedia
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the productmedia type in the database.
+	Label = "product_media"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldProductID holds the string denoting the product_id field in the database.
+	FieldProductID = "product_id"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldURL holds the string denoting the url field in the database.
+	FieldURL = "url"
+	// FieldVariants holds the string denoting the variants field in the database.
+	FieldVariants = "variants"
+	// FieldAltText holds the string denoting the alt_text field in the database.
+	FieldAltText = "alt_text"
+	// FieldPosition holds the string denoting the position field in the database.
+	FieldPosition = "position"
+	// FieldIsPrimary holds the string denoting the is_primary field in the database.
+	FieldIsPrimary = "is_primary"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeProduct holds the string denoting the product edge name in mutations.
+	EdgeProduct = "product"
+	// Table holds the table name of the productmedia in the database.
+	Table = "product_media"
+	// ProductTable is the table that holds the product relation/edge.
+	ProductTable = "product_media"
+	// ProductInverseTable is the table name for the Product entity.
+	// It exists in this package in order to avoid circular dependency with the "product" package.
+	ProductInverseTable = "products"
+	// ProductColumn is the table column denoting the product relation/edge.
+	ProductColumn = "product_id"
+)
+
+// Columns holds all SQL columns for productmedia fields.
+var Columns = []string{
+	FieldID,
+	FieldProductID,
+	FieldKind,
+	FieldURL,
+	FieldVariants,
+	FieldAltText,
+	FieldPosition,
+	FieldIsPrimary,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// URLValidator is a validator for the "url" field. It is called by the builders before save.
+	URLValidator func(string) error
+	// PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	PositionValidator func(int) error
+	// DefaultIsPrimary holds the default value on creation for the "is_primary" field.
+	DefaultIsPrimary bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// Kind values.
+const (
+	KindImage Kind = "image"
+	KindVideo Kind = "video"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindImage, KindVideo:
+		return nil
+	default:
+		return fmt.Errorf("productmedia: invalid enum value for kind field: %q", k)
+	}
+}
@@ -0,0 +1,409 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/law-a-1/product-service/ent/product"
+	"github.com/law-a-1/product-service/ent/productmedia"
+	"github.com/law-a-1/product-service/ent/schema"
+)
+
+// ProductMediaCreate is the builder for creating a ProductMedia entity.
+type ProductMediaCreate struct {
+	config
+	mutation *ProductMediaMutation
+	hooks    []Hook
+}
+
+// SetProductID sets the "product_id" field.
+func (pmc *ProductMediaCreate) SetProductID(i int) *ProductMediaCreate {
+	pmc.mutation.SetProductID(i)
+	return pmc
+}
+
+// SetKind sets the "kind" field.
+func (pmc *ProductMediaCreate) SetKind(pr productmedia.Kind) *ProductMediaCreate {
+	pmc.mutation.SetKind(pr)
+	return pmc
+}
+
+// SetURL sets the "url" field.
+func (pmc *ProductMediaCreate) SetURL(s string) *ProductMediaCreate {
+	pmc.mutation.SetURL(s)
+	return pmc
+}
+
+// SetVariants sets the "variants" field.
+func (pmc *ProductMediaCreate) SetVariants(mv map[string]schema.ImageVariant) *ProductMediaCreate {
+	pmc.mutation.SetVariants(mv)
+	return pmc
+}
+
+// SetAltText sets the "alt_text" field.
+func (pmc *ProductMediaCreate) SetAltText(s string) *ProductMediaCreate {
+	pmc.mutation.SetAltText(s)
+	return pmc
+}
+
+// SetNillableAltText sets the "alt_text" field if the given value is not nil.
+func (pmc *ProductMediaCreate) SetNillableAltText(s *string) *ProductMediaCreate {
+	if s != nil {
+		pmc.SetAltText(*s)
+	}
+	return pmc
+}
+
+// SetPosition sets the "position" field.
+func (pmc *ProductMediaCreate) SetPosition(i int) *ProductMediaCreate {
+	pmc.mutation.SetPosition(i)
+	return pmc
+}
+
+// SetIsPrimary sets the "is_primary" field.
+func (pmc *ProductMediaCreate) SetIsPrimary(b bool) *ProductMediaCreate {
+	pmc.mutation.SetIsPrimary(b)
+	return pmc
+}
+
+// SetNillableIsPrimary sets the "is_primary" field if the given value is not nil.
+func (pmc *ProductMediaCreate) SetNillableIsPrimary(b *bool) *ProductMediaCreate {
+	if b != nil {
+		pmc.SetIsPrimary(*b)
+	}
+	return pmc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pmc *ProductMediaCreate) SetCreatedAt(t time.Time) *ProductMediaCreate {
+	pmc.mutation.SetCreatedAt(t)
+	return pmc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pmc *ProductMediaCreate) SetNillableCreatedAt(t *time.Time) *ProductMediaCreate {
+	if t != nil {
+		pmc.SetCreatedAt(*t)
+	}
+	return pmc
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (pmc *ProductMediaCreate) SetProduct(p *Product) *ProductMediaCreate {
+	return pmc.SetProductID(p.ID)
+}
+
+// Mutation returns the ProductMediaMutation object of the builder.
+func (pmc *ProductMediaCreate) Mutation() *ProductMediaMutation {
+	return pmc.mutation
+}
+
+// Save creates the ProductMedia in the database.
+func (pmc *ProductMediaCreate) Save(ctx context.Context) (*ProductMedia, error) {
+	var (
+		err  error
+		node *ProductMedia
+	)
+	pmc.defaults()
+	if len(pmc.hooks) == 0 {
+		if err = pmc.check(); err != nil {
+			return nil, err
+		}
+		node, err = pmc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ProductMediaMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pmc.check(); err != nil {
+				return nil, err
+			}
+			pmc.mutation = mutation
+			if node, err = pmc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(pmc.hooks) - 1; i >= 0; i-- {
+			if pmc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pmc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pmc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pmc *ProductMediaCreate) SaveX(ctx context.Context) *ProductMedia {
+	v, err := pmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pmc *ProductMediaCreate) Exec(ctx context.Context) error {
+	_, err := pmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmc *ProductMediaCreate) ExecX(ctx context.Context) {
+	if err := pmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pmc *ProductMediaCreate) defaults() {
+	if _, ok := pmc.mutation.IsPrimary(); !ok {
+		v := productmedia.DefaultIsPrimary
+		pmc.mutation.SetIsPrimary(v)
+	}
+	if _, ok := pmc.mutation.CreatedAt(); !ok {
+		v := productmedia.DefaultCreatedAt()
+		pmc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pmc *ProductMediaCreate) check() error {
+	if _, ok := pmc.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product_id", err: errors.New(`ent: missing required field "ProductMedia.product_id"`)}
+	}
+	if _, ok := pmc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "ProductMedia.kind"`)}
+	}
+	if v, ok := pmc.mutation.Kind(); ok {
+		if err := productmedia.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "ProductMedia.kind": %w`, err)}
+		}
+	}
+	if _, ok := pmc.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "ProductMedia.url"`)}
+	}
+	if v, ok := pmc.mutation.URL(); ok {
+		if err := productmedia.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "ProductMedia.url": %w`, err)}
+		}
+	}
+	if _, ok := pmc.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "ProductMedia.position"`)}
+	}
+	if v, ok := pmc.mutation.Position(); ok {
+		if err := productmedia.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "ProductMedia.position": %w`, err)}
+		}
+	}
+	if _, ok := pmc.mutation.IsPrimary(); !ok {
+		return &ValidationError{Name: "is_primary", err: errors.New(`ent: missing required field "ProductMedia.is_primary"`)}
+	}
+	if _, ok := pmc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProductMedia.created_at"`)}
+	}
+	if _, ok := pmc.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product", err: errors.New(`ent: missing required edge "ProductMedia.product"`)}
+	}
+	return nil
+}
+
+func (pmc *ProductMediaCreate) sqlSave(ctx context.Context) (*ProductMedia, error) {
+	_node, _spec := pmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (pmc *ProductMediaCreate) createSpec() (*ProductMedia, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProductMedia{config: pmc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: productmedia.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productmedia.FieldID,
+			},
+		}
+	)
+	if value, ok := pmc.mutation.Kind(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: productmedia.FieldKind,
+		})
+		_node.Kind = value
+	}
+	if value, ok := pmc.mutation.URL(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productmedia.FieldURL,
+		})
+		_node.URL = value
+	}
+	if value, ok := pmc.mutation.Variants(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: productmedia.FieldVariants,
+		})
+		_node.Variants = value
+	}
+	if value, ok := pmc.mutation.AltText(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productmedia.FieldAltText,
+		})
+		_node.AltText = value
+	}
+	if value, ok := pmc.mutation.Position(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productmedia.FieldPosition,
+		})
+		_node.Position = value
+	}
+	if value, ok := pmc.mutation.IsPrimary(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: productmedia.FieldIsPrimary,
+		})
+		_node.IsPrimary = value
+	}
+	if value, ok := pmc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: productmedia.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := pmc.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   productmedia.ProductTable,
+			Columns: []string{productmedia.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: product.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProductID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ProductMediaCreateBulk is the builder for creating many ProductMedia entities in bulk.
+type ProductMediaCreateBulk struct {
+	config
+	builders []*ProductMediaCreate
+}
+
+// Save creates the ProductMedia entities in the database.
+func (pmcb *ProductMediaCreateBulk) Save(ctx context.Context) ([]*ProductMedia, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(pmcb.builders))
+	nodes := make([]*ProductMedia, len(pmcb.builders))
+	mutators := make([]Mutator, len(pmcb.builders))
+	for i := range pmcb.builders {
+		func(i int, root context.Context) {
+			builder := pmcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProductMediaMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pmcb *ProductMediaCreateBulk) SaveX(ctx context.Context) []*ProductMedia {
+	v, err := pmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pmcb *ProductMediaCreateBulk) Exec(ctx context.Context) error {
+	_, err := pmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmcb *ProductMediaCreateBulk) ExecX(ctx context.Context) {
+	if err := pmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,633 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/law-a-1/product-service/ent/predicate"
+	"github.com/law-a-1/product-service/ent/product"
+	"github.com/law-a-1/product-service/ent/productmedia"
+)
+
+// ProductMediaQuery is the builder for querying ProductMedia entities.
+type ProductMediaQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.ProductMedia
+	// eager-loading edges.
+	withProduct *ProductQuery
+	modifiers   []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ProductMediaQuery builder.
+func (pmq *ProductMediaQuery) Where(ps ...predicate.ProductMedia) *ProductMediaQuery {
+	pmq.predicates = append(pmq.predicates, ps...)
+	return pmq
+}
+
+// Limit adds a limit step to the query.
+func (pmq *ProductMediaQuery) Limit(limit int) *ProductMediaQuery {
+	pmq.limit = &limit
+	return pmq
+}
+
+// Offset adds an offset step to the query.
+func (pmq *ProductMediaQuery) Offset(offset int) *ProductMediaQuery {
+	pmq.offset = &offset
+	return pmq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pmq *ProductMediaQuery) Unique(unique bool) *ProductMediaQuery {
+	pmq.unique = &unique
+	return pmq
+}
+
+// Order adds an order step to the query.
+func (pmq *ProductMediaQuery) Order(o ...OrderFunc) *ProductMediaQuery {
+	pmq.order = append(pmq.order, o...)
+	return pmq
+}
+
+// QueryProduct chains the current query on the "product" edge.
+func (pmq *ProductMediaQuery) QueryProduct() *ProductQuery {
+	query := &ProductQuery{config: pmq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pmq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(productmedia.Table, productmedia.FieldID, selector),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, productmedia.ProductTable, productmedia.ProductColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pmq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ProductMedia entity from the query.
+// Returns a *NotFoundError when no ProductMedia was found.
+func (pmq *ProductMediaQuery) First(ctx context.Context) (*ProductMedia, error) {
+	nodes, err := pmq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{productmedia.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pmq *ProductMediaQuery) FirstX(ctx context.Context) *ProductMedia {
+	node, err := pmq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ProductMedia ID from the query.
+// Returns a *NotFoundError when no ProductMedia ID was found.
+func (pmq *ProductMediaQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pmq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{productmedia.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pmq *ProductMediaQuery) FirstIDX(ctx context.Context) int {
+	id, err := pmq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ProductMedia entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ProductMedia entity is found.
+// Returns a *NotFoundError when no ProductMedia entities are found.
+func (pmq *ProductMediaQuery) Only(ctx context.Context) (*ProductMedia, error) {
+	nodes, err := pmq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{productmedia.Label}
+	default:
+		return nil, &NotSingularError{productmedia.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pmq *ProductMediaQuery) OnlyX(ctx context.Context) *ProductMedia {
+	node, err := pmq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ProductMedia ID in the query.
+// Returns a *NotSingularError when more than one ProductMedia ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (pmq *ProductMediaQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pmq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{productmedia.Label}
+	default:
+		err = &NotSingularError{productmedia.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pmq *ProductMediaQuery) OnlyIDX(ctx context.Context) int {
+	id, err := pmq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ProductMediaSlice.
+func (pmq *ProductMediaQuery) All(ctx context.Context) ([]*ProductMedia, error) {
+	if err := pmq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return pmq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pmq *ProductMediaQuery) AllX(ctx context.Context) []*ProductMedia {
+	nodes, err := pmq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ProductMedia IDs.
+func (pmq *ProductMediaQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := pmq.Select(productmedia.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pmq *ProductMediaQuery) IDsX(ctx context.Context) []int {
+	ids, err := pmq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pmq *ProductMediaQuery) Count(ctx context.Context) (int, error) {
+	if err := pmq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return pmq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pmq *ProductMediaQuery) CountX(ctx context.Context) int {
+	count, err := pmq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pmq *ProductMediaQuery) Exist(ctx context.Context) (bool, error) {
+	if err := pmq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return pmq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pmq *ProductMediaQuery) ExistX(ctx context.Context) bool {
+	exist, err := pmq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ProductMediaQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pmq *ProductMediaQuery) Clone() *ProductMediaQuery {
+	if pmq == nil {
+		return nil
+	}
+	return &ProductMediaQuery{
+		config:      pmq.config,
+		limit:       pmq.limit,
+		offset:      pmq.offset,
+		order:       append([]OrderFunc{}, pmq.order...),
+		predicates:  append([]predicate.ProductMedia{}, pmq.predicates...),
+		withProduct: pmq.withProduct.Clone(),
+		// clone intermediate query.
+		sql:    pmq.sql.Clone(),
+		path:   pmq.path,
+		unique: pmq.unique,
+	}
+}
+
+// WithProduct tells the query-builder to eager-load the nodes that are connected to
+// the "product" edge. The optional arguments are used to configure the query builder of the edge.
+func (pmq *ProductMediaQuery) WithProduct(opts ...func(*ProductQuery)) *ProductMediaQuery {
+	query := &ProductQuery{config: pmq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	pmq.withProduct = query
+	return pmq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ProductID int `json:"product_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ProductMedia.Query().
+//		GroupBy(productmedia.FieldProductID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (pmq *ProductMediaQuery) GroupBy(field string, fields ...string) *ProductMediaGroupBy {
+	grbuild := &ProductMediaGroupBy{config: pmq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := pmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return pmq.sqlQuery(ctx), nil
+	}
+	grbuild.label = productmedia.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ProductID int `json:"product_id,omitempty"`
+//	}
+//
+//	client.ProductMedia.Query().
+//		Select(productmedia.FieldProductID).
+//		Scan(ctx, &v)
+//
+func (pmq *ProductMediaQuery) Select(fields ...string) *ProductMediaSelect {
+	pmq.fields = append(pmq.fields, fields...)
+	selbuild := &ProductMediaSelect{ProductMediaQuery: pmq}
+	selbuild.label = productmedia.Label
+	selbuild.flds, selbuild.scan = &pmq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (pmq *ProductMediaQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range pmq.fields {
+		if !productmedia.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if pmq.path != nil {
+		prev, err := pmq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pmq.sql = prev
+	}
+	return nil
+}
+
+func (pmq *ProductMediaQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ProductMedia, error) {
+	var (
+		nodes       = []*ProductMedia{}
+		_spec       = pmq.querySpec()
+		loadedTypes = [1]bool{
+			pmq.withProduct != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*ProductMedia).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &ProductMedia{config: pmq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(pmq.modifiers) > 0 {
+		_spec.Modifiers = pmq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, pmq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := pmq.withProduct; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*ProductMedia)
+		for i := range nodes {
+			fk := nodes[i].ProductID
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(product.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "product_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Product = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (pmq *ProductMediaQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pmq.querySpec()
+	if len(pmq.modifiers) > 0 {
+		_spec.Modifiers = pmq.modifiers
+	}
+	_spec.Node.Columns = pmq.fields
+	if len(pmq.fields) > 0 {
+		_spec.Unique = pmq.unique != nil && *pmq.unique
+	}
+	return sqlgraph.CountNodes(ctx, pmq.driver, _spec)
+}
+
+func (pmq *ProductMediaQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := pmq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (pmq *ProductMediaQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   productmedia.Table,
+			Columns: productmedia.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productmedia.FieldID,
+			},
+		},
+		From:   pmq.sql,
+		Unique: true,
+	}
+	if unique := pmq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := pmq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, productmedia.FieldID)
+		for i := range fields {
+			if fields[i] != productmedia.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := pmq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pmq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pmq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pmq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pmq *ProductMediaQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pmq.driver.Dialect())
+	t1 := builder.Table(productmedia.Table)
+	columns := pmq.fields
+	if len(columns) == 0 {
+		columns = productmedia.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pmq.sql != nil {
+		selector = pmq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if pmq.unique != nil && *pmq.unique {
+		selector.Distinct()
+	}
+	for _, m := range pmq.modifiers {
+		m(selector)
+	}
+	for _, p := range pmq.predicates {
+		p(selector)
+	}
+	for _, p := range pmq.order {
+		p(selector)
+	}
+	if offset := pmq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pmq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (pmq *ProductMediaQuery) ForUpdate(opts ...sql.LockOption) *ProductMediaQuery {
+	if pmq.driver.Dialect() == dialect.Postgres {
+		pmq.Unique(false)
+	}
+	pmq.modifiers = append(pmq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return pmq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (pmq *ProductMediaQuery) ForShare(opts ...sql.LockOption) *ProductMediaQuery {
+	if pmq.driver.Dialect() == dialect.Postgres {
+		pmq.Unique(false)
+	}
+	pmq.modifiers = append(pmq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return pmq
+}
+
+// ProductMediaGroupBy is the group-by builder for ProductMedia entities.
+type ProductMediaGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pmgb *ProductMediaGroupBy) Aggregate(fns ...AggregateFunc) *ProductMediaGroupBy {
+	pmgb.fns = append(pmgb.fns, fns...)
+	return pmgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (pmgb *ProductMediaGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := pmgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	pmgb.sql = query
+	return pmgb.sqlScan(ctx, v)
+}
+
+func (pmgb *ProductMediaGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range pmgb.fields {
+		if !productmedia.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := pmgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pmgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (pmgb *ProductMediaGroupBy) sqlQuery() *sql.Selector {
+	selector := pmgb.sql.Select()
+	aggregation := make([]string, 0, len(pmgb.fns))
+	for _, fn := range pmgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(pmgb.fields)+len(pmgb.fns))
+		for _, f := range pmgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(pmgb.fields...)...)
+}
+
+// ProductMediaSelect is the builder for selecting fields of ProductMedia entities.
+type ProductMediaSelect struct {
+	*ProductMediaQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pms *ProductMediaSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := pms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	pms.sql = pms.ProductMediaQuery.sqlQuery(ctx)
+	return pms.sqlScan(ctx, v)
+}
+
+func (pms *ProductMediaSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := pms.sql.Query()
+	if err := pms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,667 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/law-a-1/product-service/ent/predicate"
+	"github.com/law-a-1/product-service/ent/product"
+	"github.com/law-a-1/product-service/ent/productmedia"
+	"github.com/law-a-1/product-service/ent/schema"
+)
+
+// ProductMediaUpdate is the builder for updating ProductMedia entities.
+type ProductMediaUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProductMediaMutation
+}
+
+// Where appends a list predicates to the ProductMediaUpdate builder.
+func (pmu *ProductMediaUpdate) Where(ps ...predicate.ProductMedia) *ProductMediaUpdate {
+	pmu.mutation.Where(ps...)
+	return pmu
+}
+
+// SetProductID sets the "product_id" field.
+func (pmu *ProductMediaUpdate) SetProductID(i int) *ProductMediaUpdate {
+	pmu.mutation.SetProductID(i)
+	return pmu
+}
+
+// SetKind sets the "kind" field.
+func (pmu *ProductMediaUpdate) SetKind(pr productmedia.Kind) *ProductMediaUpdate {
+	pmu.mutation.SetKind(pr)
+	return pmu
+}
+
+// SetURL sets the "url" field.
+func (pmu *ProductMediaUpdate) SetURL(s string) *ProductMediaUpdate {
+	pmu.mutation.SetURL(s)
+	return pmu
+}
+
+// SetVariants sets the "variants" field.
+func (pmu *ProductMediaUpdate) SetVariants(mv map[string]schema.ImageVariant) *ProductMediaUpdate {
+	pmu.mutation.SetVariants(mv)
+	return pmu
+}
+
+// ClearVariants clears the value of the "variants" field.
+func (pmu *ProductMediaUpdate) ClearVariants() *ProductMediaUpdate {
+	pmu.mutation.ClearVariants()
+	return pmu
+}
+
+// SetAltText sets the "alt_text" field.
+func (pmu *ProductMediaUpdate) SetAltText(s string) *ProductMediaUpdate {
+	pmu.mutation.SetAltText(s)
+	return pmu
+}
+
+// SetNillableAltText sets the "alt_text" field if the given value is not nil.
+func (pmu *ProductMediaUpdate) SetNillableAltText(s *string) *ProductMediaUpdate {
+	if s != nil {
+		pmu.SetAltText(*s)
+	}
+	return pmu
+}
+
+// ClearAltText clears the value of the "alt_text" field.
+func (pmu *ProductMediaUpdate) ClearAltText() *ProductMediaUpdate {
+	pmu.mutation.ClearAltText()
+	return pmu
+}
+
+// SetPosition sets the "position" field.
+func (pmu *ProductMediaUpdate) SetPosition(i int) *ProductMediaUpdate {
+	pmu.mutation.ResetPosition()
+	pmu.mutation.SetPosition(i)
+	return pmu
+}
+
+// AddPosition adds i to the "position" field.
+func (pmu *ProductMediaUpdate) AddPosition(i int) *ProductMediaUpdate {
+	pmu.mutation.AddPosition(i)
+	return pmu
+}
+
+// SetIsPrimary sets the "is_primary" field.
+func (pmu *ProductMediaUpdate) SetIsPrimary(b bool) *ProductMediaUpdate {
+	pmu.mutation.SetIsPrimary(b)
+	return pmu
+}
+
+// SetNillableIsPrimary sets the "is_primary" field if the given value is not nil.
+func (pmu *ProductMediaUpdate) SetNillableIsPrimary(b *bool) *ProductMediaUpdate {
+	if b != nil {
+		pmu.SetIsPrimary(*b)
+	}
+	return pmu
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (pmu *ProductMediaUpdate) SetProduct(p *Product) *ProductMediaUpdate {
+	return pmu.SetProductID(p.ID)
+}
+
+// Mutation returns the ProductMediaMutation object of the builder.
+func (pmu *ProductMediaUpdate) Mutation() *ProductMediaMutation {
+	return pmu.mutation
+}
+
+// ClearProduct clears the "product" edge to the Product entity.
+func (pmu *ProductMediaUpdate) ClearProduct() *ProductMediaUpdate {
+	pmu.mutation.ClearProduct()
+	return pmu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pmu *ProductMediaUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(pmu.hooks) == 0 {
+		if err = pmu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = pmu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ProductMediaMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pmu.check(); err != nil {
+				return 0, err
+			}
+			pmu.mutation = mutation
+			affected, err = pmu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(pmu.hooks) - 1; i >= 0; i-- {
+			if pmu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pmu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pmu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pmu *ProductMediaUpdate) SaveX(ctx context.Context) int {
+	affected, err := pmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pmu *ProductMediaUpdate) Exec(ctx context.Context) error {
+	_, err := pmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmu *ProductMediaUpdate) ExecX(ctx context.Context) {
+	if err := pmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pmu *ProductMediaUpdate) check() error {
+	if v, ok := pmu.mutation.Kind(); ok {
+		if err := productmedia.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "ProductMedia.kind": %w`, err)}
+		}
+	}
+	if v, ok := pmu.mutation.URL(); ok {
+		if err := productmedia.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "ProductMedia.url": %w`, err)}
+		}
+	}
+	if v, ok := pmu.mutation.Position(); ok {
+		if err := productmedia.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "ProductMedia.position": %w`, err)}
+		}
+	}
+	if _, ok := pmu.mutation.ProductID(); pmu.mutation.ProductCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ProductMedia.product"`)
+	}
+	return nil
+}
+
+func (pmu *ProductMediaUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   productmedia.Table,
+			Columns: productmedia.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productmedia.FieldID,
+			},
+		},
+	}
+	if ps := pmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pmu.mutation.Kind(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: productmedia.FieldKind,
+		})
+	}
+	if value, ok := pmu.mutation.URL(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productmedia.FieldURL,
+		})
+	}
+	if value, ok := pmu.mutation.Variants(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: productmedia.FieldVariants,
+		})
+	}
+	if pmu.mutation.VariantsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: productmedia.FieldVariants,
+		})
+	}
+	if value, ok := pmu.mutation.AltText(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productmedia.FieldAltText,
+		})
+	}
+	if pmu.mutation.AltTextCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: productmedia.FieldAltText,
+		})
+	}
+	if value, ok := pmu.mutation.Position(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productmedia.FieldPosition,
+		})
+	}
+	if value, ok := pmu.mutation.AddedPosition(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productmedia.FieldPosition,
+		})
+	}
+	if value, ok := pmu.mutation.IsPrimary(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: productmedia.FieldIsPrimary,
+		})
+	}
+	if pmu.mutation.ProductCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   productmedia.ProductTable,
+			Columns: []string{productmedia.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: product.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pmu.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   productmedia.ProductTable,
+			Columns: []string{productmedia.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: product.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{productmedia.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ProductMediaUpdateOne is the builder for updating a single ProductMedia entity.
+type ProductMediaUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProductMediaMutation
+}
+
+// SetProductID sets the "product_id" field.
+func (pmuo *ProductMediaUpdateOne) SetProductID(i int) *ProductMediaUpdateOne {
+	pmuo.mutation.SetProductID(i)
+	return pmuo
+}
+
+// SetKind sets the "kind" field.
+func (pmuo *ProductMediaUpdateOne) SetKind(pr productmedia.Kind) *ProductMediaUpdateOne {
+	pmuo.mutation.SetKind(pr)
+	return pmuo
+}
+
+// SetURL sets the "url" field.
+func (pmuo *ProductMediaUpdateOne) SetURL(s string) *ProductMediaUpdateOne {
+	pmuo.mutation.SetURL(s)
+	return pmuo
+}
+
+// SetVariants sets the "variants" field.
+func (pmuo *ProductMediaUpdateOne) SetVariants(mv map[string]schema.ImageVariant) *ProductMediaUpdateOne {
+	pmuo.mutation.SetVariants(mv)
+	return pmuo
+}
+
+// ClearVariants clears the value of the "variants" field.
+func (pmuo *ProductMediaUpdateOne) ClearVariants() *ProductMediaUpdateOne {
+	pmuo.mutation.ClearVariants()
+	return pmuo
+}
+
+// SetAltText sets the "alt_text" field.
+func (pmuo *ProductMediaUpdateOne) SetAltText(s string) *ProductMediaUpdateOne {
+	pmuo.mutation.SetAltText(s)
+	return pmuo
+}
+
+// SetNillableAltText sets the "alt_text" field if the given value is not nil.
+func (pmuo *ProductMediaUpdateOne) SetNillableAltText(s *string) *ProductMediaUpdateOne {
+	if s != nil {
+		pmuo.SetAltText(*s)
+	}
+	return pmuo
+}
+
+// ClearAltText clears the value of the "alt_text" field.
+func (pmuo *ProductMediaUpdateOne) ClearAltText() *ProductMediaUpdateOne {
+	pmuo.mutation.ClearAltText()
+	return pmuo
+}
+
+// SetPosition sets the "position" field.
+func (pmuo *ProductMediaUpdateOne) SetPosition(i int) *ProductMediaUpdateOne {
+	pmuo.mutation.ResetPosition()
+	pmuo.mutation.SetPosition(i)
+	return pmuo
+}
+
+// AddPosition adds i to the "position" field.
+func (pmuo *ProductMediaUpdateOne) AddPosition(i int) *ProductMediaUpdateOne {
+	pmuo.mutation.AddPosition(i)
+	return pmuo
+}
+
+// SetIsPrimary sets the "is_primary" field.
+func (pmuo *ProductMediaUpdateOne) SetIsPrimary(b bool) *ProductMediaUpdateOne {
+	pmuo.mutation.SetIsPrimary(b)
+	return pmuo
+}
+
+// SetNillableIsPrimary sets the "is_primary" field if the given value is not nil.
+func (pmuo *ProductMediaUpdateOne) SetNillableIsPrimary(b *bool) *ProductMediaUpdateOne {
+	if b != nil {
+		pmuo.SetIsPrimary(*b)
+	}
+	return pmuo
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (pmuo *ProductMediaUpdateOne) SetProduct(p *Product) *ProductMediaUpdateOne {
+	return pmuo.SetProductID(p.ID)
+}
+
+// Mutation returns the ProductMediaMutation object of the builder.
+func (pmuo *ProductMediaUpdateOne) Mutation() *ProductMediaMutation {
+	return pmuo.mutation
+}
+
+// ClearProduct clears the "product" edge to the Product entity.
+func (pmuo *ProductMediaUpdateOne) ClearProduct() *ProductMediaUpdateOne {
+	pmuo.mutation.ClearProduct()
+	return pmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pmuo *ProductMediaUpdateOne) Select(field string, fields ...string) *ProductMediaUpdateOne {
+	pmuo.fields = append([]string{field}, fields...)
+	return pmuo
+}
+
+// Save executes the query and returns the updated ProductMedia entity.
+func (pmuo *ProductMediaUpdateOne) Save(ctx context.Context) (*ProductMedia, error) {
+	var (
+		err  error
+		node *ProductMedia
+	)
+	if len(pmuo.hooks) == 0 {
+		if err = pmuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = pmuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ProductMediaMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pmuo.check(); err != nil {
+				return nil, err
+			}
+			pmuo.mutation = mutation
+			node, err = pmuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(pmuo.hooks) - 1; i >= 0; i-- {
+			if pmuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pmuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pmuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pmuo *ProductMediaUpdateOne) SaveX(ctx context.Context) *ProductMedia {
+	node, err := pmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pmuo *ProductMediaUpdateOne) Exec(ctx context.Context) error {
+	_, err := pmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmuo *ProductMediaUpdateOne) ExecX(ctx context.Context) {
+	if err := pmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pmuo *ProductMediaUpdateOne) check() error {
+	if v, ok := pmuo.mutation.Kind(); ok {
+		if err := productmedia.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "ProductMedia.kind": %w`, err)}
+		}
+	}
+	if v, ok := pmuo.mutation.URL(); ok {
+		if err := productmedia.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "ProductMedia.url": %w`, err)}
+		}
+	}
+	if v, ok := pmuo.mutation.Position(); ok {
+		if err := productmedia.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "ProductMedia.position": %w`, err)}
+		}
+	}
+	if _, ok := pmuo.mutation.ProductID(); pmuo.mutation.ProductCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ProductMedia.product"`)
+	}
+	return nil
+}
+
+func (pmuo *ProductMediaUpdateOne) sqlSave(ctx context.Context) (_node *ProductMedia, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   productmedia.Table,
+			Columns: productmedia.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productmedia.FieldID,
+			},
+		},
+	}
+	id, ok := pmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProductMedia.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, productmedia.FieldID)
+		for _, f := range fields {
+			if !productmedia.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != productmedia.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pmuo.mutation.Kind(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: productmedia.FieldKind,
+		})
+	}
+	if value, ok := pmuo.mutation.URL(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productmedia.FieldURL,
+		})
+	}
+	if value, ok := pmuo.mutation.Variants(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: productmedia.FieldVariants,
+		})
+	}
+	if pmuo.mutation.VariantsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: productmedia.FieldVariants,
+		})
+	}
+	if value, ok := pmuo.mutation.AltText(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productmedia.FieldAltText,
+		})
+	}
+	if pmuo.mutation.AltTextCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: productmedia.FieldAltText,
+		})
+	}
+	if value, ok := pmuo.mutation.Position(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productmedia.FieldPosition,
+		})
+	}
+	if value, ok := pmuo.mutation.AddedPosition(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productmedia.FieldPosition,
+		})
+	}
+	if value, ok := pmuo.mutation.IsPrimary(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: productmedia.FieldIsPrimary,
+		})
+	}
+	if pmuo.mutation.ProductCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   productmedia.ProductTable,
+			Columns: []string{productmedia.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: product.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pmuo.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   productmedia.ProductTable,
+			Columns: []string{productmedia.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: product.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ProductMedia{config: pmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{productmedia.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,194 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/law-a-1/product-service/ent/product"
+	"github.com/law-a-1/product-service/ent/reservation"
+)
+
+// Reservation is the model entity for the Reservation schema.
+type Reservation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ProductID holds the value of the "product_id" field.
+	ProductID int `json:"product_id,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity int `json:"quantity,omitempty"`
+	// Owner holds the value of the "owner" field.
+	Owner string `json:"owner,omitempty"`
+	// Status holds the value of the "status" field.
+	Status reservation.Status `json:"status,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReservationQuery when eager-loading is set.
+	Edges ReservationEdges `json:"edges"`
+}
+
+// ReservationEdges holds the relations/edges for other nodes in the graph.
+type ReservationEdges struct {
+	// Product holds the value of the product edge.
+	Product *Product `json:"product,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProductOrErr returns the Product value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReservationEdges) ProductOrErr() (*Product, error) {
+	if e.loadedTypes[0] {
+		if e.Product == nil {
+			// The edge product was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: product.Label}
+		}
+		return e.Product, nil
+	}
+	return nil, &NotLoadedError{edge: "product"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Reservation) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case reservation.FieldID, reservation.FieldProductID, reservation.FieldQuantity:
+			values[i] = new(sql.NullInt64)
+		case reservation.FieldOwner, reservation.FieldStatus:
+			values[i] = new(sql.NullString)
+		case reservation.FieldExpiresAt, reservation.FieldCreatedAt, reservation.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Reservation", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Reservation fields.
+func (r *Reservation) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case reservation.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			r.ID = int(value.Int64)
+		case reservation.FieldProductID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value.Valid {
+				r.ProductID = int(value.Int64)
+			}
+		case reservation.FieldQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				r.Quantity = int(value.Int64)
+			}
+		case reservation.FieldOwner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner", values[i])
+			} else if value.Valid {
+				r.Owner = value.String
+			}
+		case reservation.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				r.Status = reservation.Status(value.String)
+			}
+		case reservation.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				r.ExpiresAt = value.Time
+			}
+		case reservation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				r.CreatedAt = value.Time
+			}
+		case reservation.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				r.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryProduct queries the "product" edge of the Reservation entity.
+func (r *Reservation) QueryProduct() *ProductQuery {
+	return (&ReservationClient{config: r.config}).QueryProduct(r)
+}
+
+// Update returns a builder for updating this Reservation.
+// Note that you need to call Reservation.Unwrap() before calling this method if this Reservation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (r *Reservation) Update() *ReservationUpdateOne {
+	return (&ReservationClient{config: r.config}).UpdateOne(r)
+}
+
+// Unwrap unwraps the Reservation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (r *Reservation) Unwrap() *Reservation {
+	tx, ok := r.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Reservation is not a transactional entity")
+	}
+	r.config.driver = tx.drv
+	return r
+}
+
+// String implements the fmt.Stringer.
+func (r *Reservation) String() string {
+	var builder strings.Builder
+	builder.WriteString("Reservation(")
+	builder.WriteString(fmt.Sprintf("id=%v", r.ID))
+	builder.WriteString(", product_id=")
+	builder.WriteString(fmt.Sprintf("%v", r.ProductID))
+	builder.WriteString(", quantity=")
+	builder.WriteString(fmt.Sprintf("%v", r.Quantity))
+	builder.WriteString(", owner=")
+	builder.WriteString(r.Owner)
+	builder.WriteString(", status=")
+	builder.WriteString(fmt.Sprintf("%v", r.Status))
+	builder.WriteString(", expires_at=")
+	builder.WriteString(r.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", created_at=")
+	builder.WriteString(r.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", updated_at=")
+	builder.WriteString(r.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Reservations is a parsable slice of Reservation.
+type Reservations []*Reservation
+
+func (r Reservations) config(cfg config) {
+	for _i := range r {
+		r[_i].config = cfg
+	}
+}
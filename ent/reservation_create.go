@@ -0,0 +1,401 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/law-a-1/product-service/ent/product"
+	"github.com/law-a-1/product-service/ent/reservation"
+)
+
+// ReservationCreate is the builder for creating a Reservation entity.
+type ReservationCreate struct {
+	config
+	mutation *ReservationMutation
+	hooks    []Hook
+}
+
+// SetProductID sets the "product_id" field.
+func (rc *ReservationCreate) SetProductID(i int) *ReservationCreate {
+	rc.mutation.SetProductID(i)
+	return rc
+}
+
+// SetQuantity sets the "quantity" field.
+func (rc *ReservationCreate) SetQuantity(i int) *ReservationCreate {
+	rc.mutation.SetQuantity(i)
+	return rc
+}
+
+// SetOwner sets the "owner" field.
+func (rc *ReservationCreate) SetOwner(s string) *ReservationCreate {
+	rc.mutation.SetOwner(s)
+	return rc
+}
+
+// SetStatus sets the "status" field.
+func (rc *ReservationCreate) SetStatus(r reservation.Status) *ReservationCreate {
+	rc.mutation.SetStatus(r)
+	return rc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rc *ReservationCreate) SetNillableStatus(r *reservation.Status) *ReservationCreate {
+	if r != nil {
+		rc.SetStatus(*r)
+	}
+	return rc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (rc *ReservationCreate) SetExpiresAt(t time.Time) *ReservationCreate {
+	rc.mutation.SetExpiresAt(t)
+	return rc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rc *ReservationCreate) SetCreatedAt(t time.Time) *ReservationCreate {
+	rc.mutation.SetCreatedAt(t)
+	return rc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rc *ReservationCreate) SetNillableCreatedAt(t *time.Time) *ReservationCreate {
+	if t != nil {
+		rc.SetCreatedAt(*t)
+	}
+	return rc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rc *ReservationCreate) SetUpdatedAt(t time.Time) *ReservationCreate {
+	rc.mutation.SetUpdatedAt(t)
+	return rc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rc *ReservationCreate) SetNillableUpdatedAt(t *time.Time) *ReservationCreate {
+	if t != nil {
+		rc.SetUpdatedAt(*t)
+	}
+	return rc
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (rc *ReservationCreate) SetProduct(p *Product) *ReservationCreate {
+	return rc.SetProductID(p.ID)
+}
+
+// Mutation returns the ReservationMutation object of the builder.
+func (rc *ReservationCreate) Mutation() *ReservationMutation {
+	return rc.mutation
+}
+
+// Save creates the Reservation in the database.
+func (rc *ReservationCreate) Save(ctx context.Context) (*Reservation, error) {
+	var (
+		err  error
+		node *Reservation
+	)
+	rc.defaults()
+	if len(rc.hooks) == 0 {
+		if err = rc.check(); err != nil {
+			return nil, err
+		}
+		node, err = rc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ReservationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = rc.check(); err != nil {
+				return nil, err
+			}
+			rc.mutation = mutation
+			if node, err = rc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(rc.hooks) - 1; i >= 0; i-- {
+			if rc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, rc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rc *ReservationCreate) SaveX(ctx context.Context) *Reservation {
+	v, err := rc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rc *ReservationCreate) Exec(ctx context.Context) error {
+	_, err := rc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rc *ReservationCreate) ExecX(ctx context.Context) {
+	if err := rc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rc *ReservationCreate) defaults() {
+	if _, ok := rc.mutation.Status(); !ok {
+		v := reservation.DefaultStatus
+		rc.mutation.SetStatus(v)
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		v := reservation.DefaultCreatedAt()
+		rc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rc.mutation.UpdatedAt(); !ok {
+		v := reservation.DefaultUpdatedAt()
+		rc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rc *ReservationCreate) check() error {
+	if _, ok := rc.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product_id", err: errors.New(`ent: missing required field "Reservation.product_id"`)}
+	}
+	if _, ok := rc.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "Reservation.quantity"`)}
+	}
+	if v, ok := rc.mutation.Quantity(); ok {
+		if err := reservation.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "Reservation.quantity": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.Owner(); !ok {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required field "Reservation.owner"`)}
+	}
+	if v, ok := rc.mutation.Owner(); ok {
+		if err := reservation.OwnerValidator(v); err != nil {
+			return &ValidationError{Name: "owner", err: fmt.Errorf(`ent: validator failed for field "Reservation.owner": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Reservation.status"`)}
+	}
+	if v, ok := rc.mutation.Status(); ok {
+		if err := reservation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Reservation.status": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "Reservation.expires_at"`)}
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Reservation.created_at"`)}
+	}
+	if _, ok := rc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Reservation.updated_at"`)}
+	}
+	if _, ok := rc.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product", err: errors.New(`ent: missing required edge "Reservation.product"`)}
+	}
+	return nil
+}
+
+func (rc *ReservationCreate) sqlSave(ctx context.Context) (*Reservation, error) {
+	_node, _spec := rc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (rc *ReservationCreate) createSpec() (*Reservation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Reservation{config: rc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: reservation.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: reservation.FieldID,
+			},
+		}
+	)
+	if value, ok := rc.mutation.Quantity(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: reservation.FieldQuantity,
+		})
+		_node.Quantity = value
+	}
+	if value, ok := rc.mutation.Owner(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reservation.FieldOwner,
+		})
+		_node.Owner = value
+	}
+	if value, ok := rc.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: reservation.FieldStatus,
+		})
+		_node.Status = value
+	}
+	if value, ok := rc.mutation.ExpiresAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: reservation.FieldExpiresAt,
+		})
+		_node.ExpiresAt = value
+	}
+	if value, ok := rc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: reservation.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := rc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: reservation.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if nodes := rc.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reservation.ProductTable,
+			Columns: []string{reservation.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: product.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProductID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ReservationCreateBulk is the builder for creating many Reservation entities in bulk.
+type ReservationCreateBulk struct {
+	config
+	builders []*ReservationCreate
+}
+
+// Save creates the Reservation entities in the database.
+func (rcb *ReservationCreateBulk) Save(ctx context.Context) ([]*Reservation, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
+	nodes := make([]*Reservation, len(rcb.builders))
+	mutators := make([]Mutator, len(rcb.builders))
+	for i := range rcb.builders {
+		func(i int, root context.Context) {
+			builder := rcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReservationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcb *ReservationCreateBulk) SaveX(ctx context.Context) []*Reservation {
+	v, err := rcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcb *ReservationCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcb *ReservationCreateBulk) ExecX(ctx context.Context) {
+	if err := rcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,593 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/law-a-1/product-service/ent/predicate"
+	"github.com/law-a-1/product-service/ent/product"
+	"github.com/law-a-1/product-service/ent/reservation"
+)
+
+// ReservationUpdate is the builder for updating Reservation entities.
+type ReservationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReservationMutation
+}
+
+// Where appends a list predicates to the ReservationUpdate builder.
+func (ru *ReservationUpdate) Where(ps ...predicate.Reservation) *ReservationUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetProductID sets the "product_id" field.
+func (ru *ReservationUpdate) SetProductID(i int) *ReservationUpdate {
+	ru.mutation.SetProductID(i)
+	return ru
+}
+
+// SetQuantity sets the "quantity" field.
+func (ru *ReservationUpdate) SetQuantity(i int) *ReservationUpdate {
+	ru.mutation.ResetQuantity()
+	ru.mutation.SetQuantity(i)
+	return ru
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (ru *ReservationUpdate) AddQuantity(i int) *ReservationUpdate {
+	ru.mutation.AddQuantity(i)
+	return ru
+}
+
+// SetOwner sets the "owner" field.
+func (ru *ReservationUpdate) SetOwner(s string) *ReservationUpdate {
+	ru.mutation.SetOwner(s)
+	return ru
+}
+
+// SetStatus sets the "status" field.
+func (ru *ReservationUpdate) SetStatus(r reservation.Status) *ReservationUpdate {
+	ru.mutation.SetStatus(r)
+	return ru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ru *ReservationUpdate) SetNillableStatus(r *reservation.Status) *ReservationUpdate {
+	if r != nil {
+		ru.SetStatus(*r)
+	}
+	return ru
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ru *ReservationUpdate) SetExpiresAt(t time.Time) *ReservationUpdate {
+	ru.mutation.SetExpiresAt(t)
+	return ru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ru *ReservationUpdate) SetUpdatedAt(t time.Time) *ReservationUpdate {
+	ru.mutation.SetUpdatedAt(t)
+	return ru
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ru *ReservationUpdate) SetNillableUpdatedAt(t *time.Time) *ReservationUpdate {
+	if t != nil {
+		ru.SetUpdatedAt(*t)
+	}
+	return ru
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (ru *ReservationUpdate) SetProduct(p *Product) *ReservationUpdate {
+	return ru.SetProductID(p.ID)
+}
+
+// Mutation returns the ReservationMutation object of the builder.
+func (ru *ReservationUpdate) Mutation() *ReservationMutation {
+	return ru.mutation
+}
+
+// ClearProduct clears the "product" edge to the Product entity.
+func (ru *ReservationUpdate) ClearProduct() *ReservationUpdate {
+	ru.mutation.ClearProduct()
+	return ru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *ReservationUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ru.hooks) == 0 {
+		if err = ru.check(); err != nil {
+			return 0, err
+		}
+		affected, err = ru.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ReservationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ru.check(); err != nil {
+				return 0, err
+			}
+			ru.mutation = mutation
+			affected, err = ru.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ru.hooks) - 1; i >= 0; i-- {
+			if ru.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ru.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ru.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *ReservationUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *ReservationUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *ReservationUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ru *ReservationUpdate) check() error {
+	if v, ok := ru.mutation.Quantity(); ok {
+		if err := reservation.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "Reservation.quantity": %w`, err)}
+		}
+	}
+	if v, ok := ru.mutation.Owner(); ok {
+		if err := reservation.OwnerValidator(v); err != nil {
+			return &ValidationError{Name: "owner", err: fmt.Errorf(`ent: validator failed for field "Reservation.owner": %w`, err)}
+		}
+	}
+	if v, ok := ru.mutation.Status(); ok {
+		if err := reservation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Reservation.status": %w`, err)}
+		}
+	}
+	if _, ok := ru.mutation.ProductID(); ru.mutation.ProductCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Reservation.product"`)
+	}
+	return nil
+}
+
+func (ru *ReservationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   reservation.Table,
+			Columns: reservation.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: reservation.FieldID,
+			},
+		},
+	}
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ru.mutation.Quantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: reservation.FieldQuantity,
+		})
+	}
+	if value, ok := ru.mutation.AddedQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: reservation.FieldQuantity,
+		})
+	}
+	if value, ok := ru.mutation.Owner(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reservation.FieldOwner,
+		})
+	}
+	if value, ok := ru.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: reservation.FieldStatus,
+		})
+	}
+	if value, ok := ru.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: reservation.FieldExpiresAt,
+		})
+	}
+	if value, ok := ru.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: reservation.FieldUpdatedAt,
+		})
+	}
+	if ru.mutation.ProductCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reservation.ProductTable,
+			Columns: []string{reservation.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: product.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reservation.ProductTable,
+			Columns: []string{reservation.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: product.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reservation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ReservationUpdateOne is the builder for updating a single Reservation entity.
+type ReservationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReservationMutation
+}
+
+// SetProductID sets the "product_id" field.
+func (ruo *ReservationUpdateOne) SetProductID(i int) *ReservationUpdateOne {
+	ruo.mutation.SetProductID(i)
+	return ruo
+}
+
+// SetQuantity sets the "quantity" field.
+func (ruo *ReservationUpdateOne) SetQuantity(i int) *ReservationUpdateOne {
+	ruo.mutation.ResetQuantity()
+	ruo.mutation.SetQuantity(i)
+	return ruo
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (ruo *ReservationUpdateOne) AddQuantity(i int) *ReservationUpdateOne {
+	ruo.mutation.AddQuantity(i)
+	return ruo
+}
+
+// SetOwner sets the "owner" field.
+func (ruo *ReservationUpdateOne) SetOwner(s string) *ReservationUpdateOne {
+	ruo.mutation.SetOwner(s)
+	return ruo
+}
+
+// SetStatus sets the "status" field.
+func (ruo *ReservationUpdateOne) SetStatus(r reservation.Status) *ReservationUpdateOne {
+	ruo.mutation.SetStatus(r)
+	return ruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ruo *ReservationUpdateOne) SetNillableStatus(r *reservation.Status) *ReservationUpdateOne {
+	if r != nil {
+		ruo.SetStatus(*r)
+	}
+	return ruo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ruo *ReservationUpdateOne) SetExpiresAt(t time.Time) *ReservationUpdateOne {
+	ruo.mutation.SetExpiresAt(t)
+	return ruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ruo *ReservationUpdateOne) SetUpdatedAt(t time.Time) *ReservationUpdateOne {
+	ruo.mutation.SetUpdatedAt(t)
+	return ruo
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ruo *ReservationUpdateOne) SetNillableUpdatedAt(t *time.Time) *ReservationUpdateOne {
+	if t != nil {
+		ruo.SetUpdatedAt(*t)
+	}
+	return ruo
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (ruo *ReservationUpdateOne) SetProduct(p *Product) *ReservationUpdateOne {
+	return ruo.SetProductID(p.ID)
+}
+
+// Mutation returns the ReservationMutation object of the builder.
+func (ruo *ReservationUpdateOne) Mutation() *ReservationMutation {
+	return ruo.mutation
+}
+
+// ClearProduct clears the "product" edge to the Product entity.
+func (ruo *ReservationUpdateOne) ClearProduct() *ReservationUpdateOne {
+	ruo.mutation.ClearProduct()
+	return ruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *ReservationUpdateOne) Select(field string, fields ...string) *ReservationUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Reservation entity.
+func (ruo *ReservationUpdateOne) Save(ctx context.Context) (*Reservation, error) {
+	var (
+		err  error
+		node *Reservation
+	)
+	if len(ruo.hooks) == 0 {
+		if err = ruo.check(); err != nil {
+			return nil, err
+		}
+		node, err = ruo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ReservationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ruo.check(); err != nil {
+				return nil, err
+			}
+			ruo.mutation = mutation
+			node, err = ruo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ruo.hooks) - 1; i >= 0; i-- {
+			if ruo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ruo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ruo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *ReservationUpdateOne) SaveX(ctx context.Context) *Reservation {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *ReservationUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *ReservationUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruo *ReservationUpdateOne) check() error {
+	if v, ok := ruo.mutation.Quantity(); ok {
+		if err := reservation.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "Reservation.quantity": %w`, err)}
+		}
+	}
+	if v, ok := ruo.mutation.Owner(); ok {
+		if err := reservation.OwnerValidator(v); err != nil {
+			return &ValidationError{Name: "owner", err: fmt.Errorf(`ent: validator failed for field "Reservation.owner": %w`, err)}
+		}
+	}
+	if v, ok := ruo.mutation.Status(); ok {
+		if err := reservation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Reservation.status": %w`, err)}
+		}
+	}
+	if _, ok := ruo.mutation.ProductID(); ruo.mutation.ProductCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Reservation.product"`)
+	}
+	return nil
+}
+
+func (ruo *ReservationUpdateOne) sqlSave(ctx context.Context) (_node *Reservation, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   reservation.Table,
+			Columns: reservation.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: reservation.FieldID,
+			},
+		},
+	}
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Reservation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reservation.FieldID)
+		for _, f := range fields {
+			if !reservation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reservation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruo.mutation.Quantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: reservation.FieldQuantity,
+		})
+	}
+	if value, ok := ruo.mutation.AddedQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: reservation.FieldQuantity,
+		})
+	}
+	if value, ok := ruo.mutation.Owner(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: reservation.FieldOwner,
+		})
+	}
+	if value, ok := ruo.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: reservation.FieldStatus,
+		})
+	}
+	if value, ok := ruo.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: reservation.FieldExpiresAt,
+		})
+	}
+	if value, ok := ruo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: reservation.FieldUpdatedAt,
+		})
+	}
+	if ruo.mutation.ProductCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reservation.ProductTable,
+			Columns: []string{reservation.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: product.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reservation.ProductTable,
+			Columns: []string{reservation.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: product.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Reservation{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reservation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -5,14 +5,44 @@ package ent
 import (
 	"time"
 
+	"github.com/law-a-1/product-service/ent/idempotencykey"
 	"github.com/law-a-1/product-service/ent/product"
+	"github.com/law-a-1/product-service/ent/productmedia"
+	"github.com/law-a-1/product-service/ent/reservation"
 	"github.com/law-a-1/product-service/ent/schema"
+	"github.com/law-a-1/product-service/ent/stockmovement"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	idempotencykeyFields := schema.IdempotencyKey{}.Fields()
+	_ = idempotencykeyFields
+	// idempotencykeyDescKey is the schema descriptor for key field.
+	idempotencykeyDescKey := idempotencykeyFields[0].Descriptor()
+	// idempotencykey.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	idempotencykey.KeyValidator = idempotencykeyDescKey.Validators[0].(func(string) error)
+	// idempotencykeyDescCaller is the schema descriptor for caller field.
+	idempotencykeyDescCaller := idempotencykeyFields[1].Descriptor()
+	// idempotencykey.DefaultCaller holds the default value on creation for the caller field.
+	idempotencykey.DefaultCaller = idempotencykeyDescCaller.Default.(string)
+	// idempotencykeyDescMethod is the schema descriptor for method field.
+	idempotencykeyDescMethod := idempotencykeyFields[2].Descriptor()
+	// idempotencykey.MethodValidator is a validator for the "method" field. It is called by the builders before save.
+	idempotencykey.MethodValidator = idempotencykeyDescMethod.Validators[0].(func(string) error)
+	// idempotencykeyDescRequestHash is the schema descriptor for request_hash field.
+	idempotencykeyDescRequestHash := idempotencykeyFields[3].Descriptor()
+	// idempotencykey.RequestHashValidator is a validator for the "request_hash" field. It is called by the builders before save.
+	idempotencykey.RequestHashValidator = idempotencykeyDescRequestHash.Validators[0].(func(string) error)
+	// idempotencykeyDescCreatedAt is the schema descriptor for created_at field.
+	idempotencykeyDescCreatedAt := idempotencykeyFields[7].Descriptor()
+	// idempotencykey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	idempotencykey.DefaultCreatedAt = idempotencykeyDescCreatedAt.Default.(func() time.Time)
+	// idempotencykeyDescClaimedAt is the schema descriptor for claimed_at field.
+	idempotencykeyDescClaimedAt := idempotencykeyFields[8].Descriptor()
+	// idempotencykey.DefaultClaimedAt holds the default value on creation for the claimed_at field.
+	idempotencykey.DefaultClaimedAt = idempotencykeyDescClaimedAt.Default.(func() time.Time)
 	productFields := schema.Product{}.Fields()
 	_ = productFields
 	// productDescName is the schema descriptor for name field.
@@ -32,11 +62,53 @@ func init() {
 	// product.StockValidator is a validator for the "stock" field. It is called by the builders before save.
 	product.StockValidator = productDescStock.Validators[0].(func(int) error)
 	// productDescCreatedAt is the schema descriptor for created_at field.
-	productDescCreatedAt := productFields[6].Descriptor()
+	productDescCreatedAt := productFields[7].Descriptor()
 	// product.DefaultCreatedAt holds the default value on creation for the created_at field.
 	product.DefaultCreatedAt = productDescCreatedAt.Default.(func() time.Time)
 	// productDescUpdatedAt is the schema descriptor for updated_at field.
-	productDescUpdatedAt := productFields[7].Descriptor()
+	productDescUpdatedAt := productFields[8].Descriptor()
 	// product.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	product.DefaultUpdatedAt = productDescUpdatedAt.Default.(func() time.Time)
+	productmediaFields := schema.ProductMedia{}.Fields()
+	_ = productmediaFields
+	// productmediaDescURL is the schema descriptor for url field.
+	productmediaDescURL := productmediaFields[2].Descriptor()
+	// productmedia.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	productmedia.URLValidator = productmediaDescURL.Validators[0].(func(string) error)
+	// productmediaDescPosition is the schema descriptor for position field.
+	productmediaDescPosition := productmediaFields[5].Descriptor()
+	// productmedia.PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	productmedia.PositionValidator = productmediaDescPosition.Validators[0].(func(int) error)
+	// productmediaDescIsPrimary is the schema descriptor for is_primary field.
+	productmediaDescIsPrimary := productmediaFields[6].Descriptor()
+	// productmedia.DefaultIsPrimary holds the default value on creation for the is_primary field.
+	productmedia.DefaultIsPrimary = productmediaDescIsPrimary.Default.(bool)
+	// productmediaDescCreatedAt is the schema descriptor for created_at field.
+	productmediaDescCreatedAt := productmediaFields[7].Descriptor()
+	// productmedia.DefaultCreatedAt holds the default value on creation for the created_at field.
+	productmedia.DefaultCreatedAt = productmediaDescCreatedAt.Default.(func() time.Time)
+	reservationFields := schema.Reservation{}.Fields()
+	_ = reservationFields
+	// reservationDescQuantity is the schema descriptor for quantity field.
+	reservationDescQuantity := reservationFields[1].Descriptor()
+	// reservation.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	reservation.QuantityValidator = reservationDescQuantity.Validators[0].(func(int) error)
+	// reservationDescOwner is the schema descriptor for owner field.
+	reservationDescOwner := reservationFields[2].Descriptor()
+	// reservation.OwnerValidator is a validator for the "owner" field. It is called by the builders before save.
+	reservation.OwnerValidator = reservationDescOwner.Validators[0].(func(string) error)
+	// reservationDescCreatedAt is the schema descriptor for created_at field.
+	reservationDescCreatedAt := reservationFields[5].Descriptor()
+	// reservation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	reservation.DefaultCreatedAt = reservationDescCreatedAt.Default.(func() time.Time)
+	// reservationDescUpdatedAt is the schema descriptor for updated_at field.
+	reservationDescUpdatedAt := reservationFields[6].Descriptor()
+	// reservation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	reservation.DefaultUpdatedAt = reservationDescUpdatedAt.Default.(func() time.Time)
+	stockmovementFields := schema.StockMovement{}.Fields()
+	_ = stockmovementFields
+	// stockmovementDescCreatedAt is the schema descriptor for created_at field.
+	stockmovementDescCreatedAt := stockmovementFields[6].Descriptor()
+	// stockmovement.DefaultCreatedAt holds the default value on creation for the created_at field.
+	stockmovement.DefaultCreatedAt = stockmovementDescCreatedAt.Default.(func() time.Time)
 }
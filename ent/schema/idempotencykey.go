@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// IdempotencyKey holds the schema definition for the IdempotencyKey entity.
+type IdempotencyKey struct {
+	ent.Schema
+}
+
+// Fields of the IdempotencyKey.
+func (IdempotencyKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key").NotEmpty(),
+		field.String("caller").Default(""), // Identity of the authenticated caller, empty for anonymous calls
+		field.String("method").NotEmpty(),
+		field.String("request_hash").NotEmpty(),
+		field.Enum("state").Values("pending", "completed").Default("pending"),
+		field.Bytes("response").Optional(), // Serialised google.protobuf.Any of the response
+		field.Bytes("status").Optional(),   // Serialised google.rpc.Status of a failed call
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("claimed_at").Default(time.Now), // Start of the lease of a pending call
+		field.Time("expires_at"),
+	}
+}
+
+// Edges of the IdempotencyKey.
+func (IdempotencyKey) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the IdempotencyKey.
+func (IdempotencyKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("caller", "method", "key").Unique(),
+		index.Fields("expires_at"),
+	}
+}
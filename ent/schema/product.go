@@ -2,6 +2,8 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"time"
 )
@@ -19,6 +21,7 @@ func (Product) Fields() []ent.Field {
 		field.Int("price").Min(999), // Price must be > Rp999
 		field.Int("stock").Min(-1),  // Stock must be > -1
 		field.String("image").Optional(),
+		field.JSON("image_variants", map[string]ImageVariant{}).Optional(), // Keyed by size name
 		field.String("video").Optional(),
 		field.Time("created_at").Default(time.Now).Immutable(),
 		field.Time("updated_at").Default(time.Now),
@@ -27,5 +30,12 @@ func (Product) Fields() []ent.Field {
 
 // Edges of the Product.
 func (Product) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.To("reservations", Reservation.Type).
+			Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+		edge.To("movements", StockMovement.Type).
+			Annotations(entsql.Annotation{OnDelete: entsql.SetNull}),
+		edge.To("media", ProductMedia.Type).
+			Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+	}
 }
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// ProductMedia holds the schema definition for the ProductMedia entity.
+type ProductMedia struct {
+	ent.Schema
+}
+
+// Fields of the ProductMedia.
+func (ProductMedia) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("product_id"),
+		field.Enum("kind").Values("image", "video"),
+		field.String("url").NotEmpty(),
+		field.JSON("variants", map[string]ImageVariant{}).Optional(), // Keyed by size name, images only
+		field.String("alt_text").Optional(),
+		field.Int("position").NonNegative(),
+		field.Bool("is_primary").Default(false),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the ProductMedia.
+func (ProductMedia) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("product", Product.Type).
+			Ref("media").
+			Field("product_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the ProductMedia.
+func (ProductMedia) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("product_id", "position"),
+	}
+}
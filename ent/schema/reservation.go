@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// Reservation holds the schema definition for the Reservation entity.
+type Reservation struct {
+	ent.Schema
+}
+
+// Fields of the Reservation.
+func (Reservation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("product_id"),
+		field.Int("quantity").Positive(),
+		field.String("owner").NotEmpty(), // Order or cart ID holding the stock
+		field.Enum("status").Values("active", "confirmed", "cancelled", "expired").Default("active"),
+		field.Time("expires_at"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now),
+	}
+}
+
+// Edges of the Reservation.
+func (Reservation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("product", Product.Type).
+			Ref("reservations").
+			Field("product_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Reservation.
+func (Reservation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("product_id", "status"),
+		index.Fields("status", "expires_at"),
+	}
+}
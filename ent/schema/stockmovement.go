@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// StockMovement holds the schema definition for the StockMovement entity.
+type StockMovement struct {
+	ent.Schema
+}
+
+// Fields of the StockMovement.
+func (StockMovement) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("product_id").Optional(), // Kept empty once the product is deleted
+		field.Int("delta"),
+		field.Int("stock_after"),
+		field.Enum("reason").Values("initial", "sale", "restock", "return", "cancellation", "adjustment"),
+		field.String("actor").Optional(),
+		field.String("reference_id").Optional(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the StockMovement.
+func (StockMovement) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("product", Product.Type).
+			Ref("movements").
+			Field("product_id").
+			Unique(),
+	}
+}
+
+// Indexes of the StockMovement.
+func (StockMovement) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("product_id", "created_at"),
+	}
+}
@@ -0,0 +1,10 @@
+package schema
+
+// ImageVariant is a resized copy of a product image, stored both as JPEG (or
+// PNG for transparent images) and as WebP.
+type ImageVariant struct {
+	URL    string `json:"url"`
+	WebP   string `json:"webp"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
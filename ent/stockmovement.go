@@ -0,0 +1,194 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/law-a-1/product-service/ent/product"
+	"github.com/law-a-1/product-service/ent/stockmovement"
+)
+
+// StockMovement is the model entity for the StockMovement schema.
+type StockMovement struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ProductID holds the value of the "product_id" field.
+	ProductID int `json:"product_id,omitempty"`
+	// Delta holds the value of the "delta" field.
+	Delta int `json:"delta,omitempty"`
+	// StockAfter holds the value of the "stock_after" field.
+	StockAfter int `json:"stock_after,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason stockmovement.Reason `json:"reason,omitempty"`
+	// Actor holds the value of the "actor" field.
+	Actor string `json:"actor,omitempty"`
+	// ReferenceID holds the value of the "reference_id" field.
+	ReferenceID string `json:"reference_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the StockMovementQuery when eager-loading is set.
+	Edges StockMovementEdges `json:"edges"`
+}
+
+// StockMovementEdges holds the relations/edges for other nodes in the graph.
+type StockMovementEdges struct {
+	// Product holds the value of the product edge.
+	Product *Product `json:"product,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProductOrErr returns the Product value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StockMovementEdges) ProductOrErr() (*Product, error) {
+	if e.loadedTypes[0] {
+		if e.Product == nil {
+			// The edge product was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: product.Label}
+		}
+		return e.Product, nil
+	}
+	return nil, &NotLoadedError{edge: "product"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StockMovement) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case stockmovement.FieldID, stockmovement.FieldProductID, stockmovement.FieldDelta, stockmovement.FieldStockAfter:
+			values[i] = new(sql.NullInt64)
+		case stockmovement.FieldReason, stockmovement.FieldActor, stockmovement.FieldReferenceID:
+			values[i] = new(sql.NullString)
+		case stockmovement.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type StockMovement", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StockMovement fields.
+func (sm *StockMovement) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case stockmovement.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sm.ID = int(value.Int64)
+		case stockmovement.FieldProductID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value.Valid {
+				sm.ProductID = int(value.Int64)
+			}
+		case stockmovement.FieldDelta:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field delta", values[i])
+			} else if value.Valid {
+				sm.Delta = int(value.Int64)
+			}
+		case stockmovement.FieldStockAfter:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field stock_after", values[i])
+			} else if value.Valid {
+				sm.StockAfter = int(value.Int64)
+			}
+		case stockmovement.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				sm.Reason = stockmovement.Reason(value.String)
+			}
+		case stockmovement.FieldActor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor", values[i])
+			} else if value.Valid {
+				sm.Actor = value.String
+			}
+		case stockmovement.FieldReferenceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reference_id", values[i])
+			} else if value.Valid {
+				sm.ReferenceID = value.String
+			}
+		case stockmovement.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sm.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryProduct queries the "product" edge of the StockMovement entity.
+func (sm *StockMovement) QueryProduct() *ProductQuery {
+	return (&StockMovementClient{config: sm.config}).QueryProduct(sm)
+}
+
+// Update returns a builder for updating this StockMovement.
+// Note that you need to call StockMovement.Unwrap() before calling this method if this StockMovement
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sm *StockMovement) Update() *StockMovementUpdateOne {
+	return (&StockMovementClient{config: sm.config}).UpdateOne(sm)
+}
+
+// Unwrap unwraps the StockMovement entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sm *StockMovement) Unwrap() *StockMovement {
+	tx, ok := sm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StockMovement is not a transactional entity")
+	}
+	sm.config.driver = tx.drv
+	return sm
+}
+
+// String implements the fmt.Stringer.
+func (sm *StockMovement) String() string {
+	var builder strings.Builder
+	builder.WriteString("StockMovement(")
+	builder.WriteString(fmt.Sprintf("id=%v", sm.ID))
+	builder.WriteString(", product_id=")
+	builder.WriteString(fmt.Sprintf("%v", sm.ProductID))
+	builder.WriteString(", delta=")
+	builder.WriteString(fmt.Sprintf("%v", sm.Delta))
+	builder.WriteString(", stock_after=")
+	builder.WriteString(fmt.Sprintf("%v", sm.StockAfter))
+	builder.WriteString(", reason=")
+	builder.WriteString(fmt.Sprintf("%v", sm.Reason))
+	builder.WriteString(", actor=")
+	builder.WriteString(sm.Actor)
+	builder.WriteString(", reference_id=")
+	builder.WriteString(sm.ReferenceID)
+	builder.WriteString(", created_at=")
+	builder.WriteString(sm.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StockMovements is a parsable slice of StockMovement.
+type StockMovements []*StockMovement
+
+func (sm StockMovements) config(cfg config) {
+	for _i := range sm {
+		sm[_i].config = cfg
+	}
+}
@@ -0,0 +1,94 @@
+// Code generated by entc, DO NOT EDIT.
+
+package stockmovement
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the stockmovement type in the database.
+	Label = "stock_movement"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldProductID holds the string denoting the product_id field in the database.
+	FieldProductID = "product_id"
+	// FieldDelta holds the string denoting the delta field in the database.
+	FieldDelta = "delta"
+	// FieldStockAfter holds the string denoting the stock_after field in the database.
+	FieldStockAfter = "stock_after"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldActor holds the string denoting the actor field in the database.
+	FieldActor = "actor"
+	// FieldReferenceID holds the string denoting the reference_id field in the database.
+	FieldReferenceID = "reference_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeProduct holds the string denoting the product edge name in mutations.
+	EdgeProduct = "product"
+	// Table holds the table name of the stockmovement in the database.
+	Table = "stock_movements"
+	// ProductTable is the table that holds the product relation/edge.
+	ProductTable = "stock_movements"
+	// ProductInverseTable is the table name for the Product entity.
+	// It exists in this package in order to avoid circular dependency with the "product" package.
+	ProductInverseTable = "products"
+	// ProductColumn is the table column denoting the product relation/edge.
+	ProductColumn = "product_id"
+)
+
+// Columns holds all SQL columns for stockmovement fields.
+var Columns = []string{
+	FieldID,
+	FieldProductID,
+	FieldDelta,
+	FieldStockAfter,
+	FieldReason,
+	FieldActor,
+	FieldReferenceID,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Reason defines the type for the "reason" enum field.
+type Reason string
+
+// Reason values.
+const (
+	ReasonInitial      Reason = "initial"
+	ReasonSale         Reason = "sale"
+	ReasonRestock      Reason = "restock"
+	ReasonReturn       Reason = "return"
+	ReasonCancellation Reason = "cancellation"
+	ReasonAdjustment   Reason = "adjustment"
+)
+
+func (r Reason) String() string {
+	return string(r)
+}
+
+// ReasonValidator is a validator for the "reason" field enum values. It is called by the builders before save.
+func ReasonValidator(r Reason) error {
+	switch r {
+	case ReasonInitial, ReasonSale, ReasonRestock, ReasonReturn, ReasonCancellation, ReasonAdjustment:
+		return nil
+	default:
+		return fmt.Errorf("stockmovement: invalid enum value for reason field: %q", r)
+	}
+}
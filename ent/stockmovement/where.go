@@ -0,0 +1,784 @@
+// Code generated by entc, DO NOT EDIT.
+
+package stockmovement
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/law-a-1/product-service/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// ProductID applies equality check predicate on the "product_id" field. It's identical to ProductIDEQ.
+func ProductID(v int) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProductID), v))
+	})
+}
+
+// Delta applies equality check predicate on the "delta" field. It's identical to DeltaEQ.
+func Delta(v int) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDelta), v))
+	})
+}
+
+// StockAfter applies equality check predicate on the "stock_after" field. It's identical to StockAfterEQ.
+func StockAfter(v int) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStockAfter), v))
+	})
+}
+
+// Actor applies equality check predicate on the "actor" field. It's identical to ActorEQ.
+func Actor(v string) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldActor), v))
+	})
+}
+
+// ReferenceID applies equality check predicate on the "reference_id" field. It's identical to ReferenceIDEQ.
+func ReferenceID(v string) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReferenceID), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// ProductIDEQ applies the EQ predicate on the "product_id" field.
+func ProductIDEQ(v int) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDNEQ applies the NEQ predicate on the "product_id" field.
+func ProductIDNEQ(v int) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDIn applies the In predicate on the "product_id" field.
+func ProductIDIn(vs ...int) predicate.StockMovement {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockMovement(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldProductID), v...))
+	})
+}
+
+// ProductIDNotIn applies the NotIn predicate on the "product_id" field.
+func ProductIDNotIn(vs ...int) predicate.StockMovement {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockMovement(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldProductID), v...))
+	})
+}
+
+// ProductIDIsNil applies the IsNil predicate on the "product_id" field.
+func ProductIDIsNil() predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldProductID)))
+	})
+}
+
+// ProductIDNotNil applies the NotNil predicate on the "product_id" field.
+func ProductIDNotNil() predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldProductID)))
+	})
+}
+
+// DeltaEQ applies the EQ predicate on the "delta" field.
+func DeltaEQ(v int) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDelta), v))
+	})
+}
+
+// DeltaNEQ applies the NEQ predicate on the "delta" field.
+func DeltaNEQ(v int) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDelta), v))
+	})
+}
+
+// DeltaIn applies the In predicate on the "delta" field.
+func DeltaIn(vs ...int) predicate.StockMovement {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockMovement(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDelta), v...))
+	})
+}
+
+// DeltaNotIn applies the NotIn predicate on the "delta" field.
+func DeltaNotIn(vs ...int) predicate.StockMovement {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockMovement(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDelta), v...))
+	})
+}
+
+// DeltaGT applies the GT predicate on the "delta" field.
+func DeltaGT(v int) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDelta), v))
+	})
+}
+
+// DeltaGTE applies the GTE predicate on the "delta" field.
+func DeltaGTE(v int) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDelta), v))
+	})
+}
+
+// DeltaLT applies the LT predicate on the "delta" field.
+func DeltaLT(v int) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDelta), v))
+	})
+}
+
+// DeltaLTE applies the LTE predicate on the "delta" field.
+func DeltaLTE(v int) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDelta), v))
+	})
+}
+
+// StockAfterEQ applies the EQ predicate on the "stock_after" field.
+func StockAfterEQ(v int) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStockAfter), v))
+	})
+}
+
+// StockAfterNEQ applies the NEQ predicate on the "stock_after" field.
+func StockAfterNEQ(v int) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStockAfter), v))
+	})
+}
+
+// StockAfterIn applies the In predicate on the "stock_after" field.
+func StockAfterIn(vs ...int) predicate.StockMovement {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockMovement(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStockAfter), v...))
+	})
+}
+
+// StockAfterNotIn applies the NotIn predicate on the "stock_after" field.
+func StockAfterNotIn(vs ...int) predicate.StockMovement {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockMovement(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStockAfter), v...))
+	})
+}
+
+// StockAfterGT applies the GT predicate on the "stock_after" field.
+func StockAfterGT(v int) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStockAfter), v))
+	})
+}
+
+// StockAfterGTE applies the GTE predicate on the "stock_after" field.
+func StockAfterGTE(v int) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStockAfter), v))
+	})
+}
+
+// StockAfterLT applies the LT predicate on the "stock_after" field.
+func StockAfterLT(v int) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStockAfter), v))
+	})
+}
+
+// StockAfterLTE applies the LTE predicate on the "stock_after" field.
+func StockAfterLTE(v int) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStockAfter), v))
+	})
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v Reason) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReason), v))
+	})
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v Reason) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldReason), v))
+	})
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...Reason) predicate.StockMovement {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockMovement(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldReason), v...))
+	})
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...Reason) predicate.StockMovement {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockMovement(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldReason), v...))
+	})
+}
+
+// ActorEQ applies the EQ predicate on the "actor" field.
+func ActorEQ(v string) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldActor), v))
+	})
+}
+
+// ActorNEQ applies the NEQ predicate on the "actor" field.
+func ActorNEQ(v string) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldActor), v))
+	})
+}
+
+// ActorIn applies the In predicate on the "actor" field.
+func ActorIn(vs ...string) predicate.StockMovement {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockMovement(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldActor), v...))
+	})
+}
+
+// ActorNotIn applies the NotIn predicate on the "actor" field.
+func ActorNotIn(vs ...string) predicate.StockMovement {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockMovement(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldActor), v...))
+	})
+}
+
+// ActorGT applies the GT predicate on the "actor" field.
+func ActorGT(v string) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldActor), v))
+	})
+}
+
+// ActorGTE applies the GTE predicate on the "actor" field.
+func ActorGTE(v string) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldActor), v))
+	})
+}
+
+// ActorLT applies the LT predicate on the "actor" field.
+func ActorLT(v string) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldActor), v))
+	})
+}
+
+// ActorLTE applies the LTE predicate on the "actor" field.
+func ActorLTE(v string) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldActor), v))
+	})
+}
+
+// ActorContains applies the Contains predicate on the "actor" field.
+func ActorContains(v string) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldActor), v))
+	})
+}
+
+// ActorHasPrefix applies the HasPrefix predicate on the "actor" field.
+func ActorHasPrefix(v string) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldActor), v))
+	})
+}
+
+// ActorHasSuffix applies the HasSuffix predicate on the "actor" field.
+func ActorHasSuffix(v string) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldActor), v))
+	})
+}
+
+// ActorIsNil applies the IsNil predicate on the "actor" field.
+func ActorIsNil() predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldActor)))
+	})
+}
+
+// ActorNotNil applies the NotNil predicate on the "actor" field.
+func ActorNotNil() predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldActor)))
+	})
+}
+
+// ActorEqualFold applies the EqualFold predicate on the "actor" field.
+func ActorEqualFold(v string) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldActor), v))
+	})
+}
+
+// ActorContainsFold applies the ContainsFold predicate on the "actor" field.
+func ActorContainsFold(v string) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldActor), v))
+	})
+}
+
+// ReferenceIDEQ applies the EQ predicate on the "reference_id" field.
+func ReferenceIDEQ(v string) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReferenceID), v))
+	})
+}
+
+// ReferenceIDNEQ applies the NEQ predicate on the "reference_id" field.
+func ReferenceIDNEQ(v string) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldReferenceID), v))
+	})
+}
+
+// ReferenceIDIn applies the In predicate on the "reference_id" field.
+func ReferenceIDIn(vs ...string) predicate.StockMovement {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockMovement(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldReferenceID), v...))
+	})
+}
+
+// ReferenceIDNotIn applies the NotIn predicate on the "reference_id" field.
+func ReferenceIDNotIn(vs ...string) predicate.StockMovement {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockMovement(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldReferenceID), v...))
+	})
+}
+
+// ReferenceIDGT applies the GT predicate on the "reference_id" field.
+func ReferenceIDGT(v string) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldReferenceID), v))
+	})
+}
+
+// ReferenceIDGTE applies the GTE predicate on the "reference_id" field.
+func ReferenceIDGTE(v string) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldReferenceID), v))
+	})
+}
+
+// ReferenceIDLT applies the LT predicate on the "reference_id" field.
+func ReferenceIDLT(v string) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldReferenceID), v))
+	})
+}
+
+// ReferenceIDLTE applies the LTE predicate on the "reference_id" field.
+func ReferenceIDLTE(v string) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldReferenceID), v))
+	})
+}
+
+// ReferenceIDContains applies the Contains predicate on the "reference_id" field.
+func ReferenceIDContains(v string) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldReferenceID), v))
+	})
+}
+
+// ReferenceIDHasPrefix applies the HasPrefix predicate on the "reference_id" field.
+func ReferenceIDHasPrefix(v string) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldReferenceID), v))
+	})
+}
+
+// ReferenceIDHasSuffix applies the HasSuffix predicate on the "reference_id" field.
+func ReferenceIDHasSuffix(v string) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldReferenceID), v))
+	})
+}
+
+// ReferenceIDIsNil applies the IsNil predicate on the "reference_id" field.
+func ReferenceIDIsNil() predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldReferenceID)))
+	})
+}
+
+// ReferenceIDNotNil applies the NotNil predicate on the "reference_id" field.
+func ReferenceIDNotNil() predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldReferenceID)))
+	})
+}
+
+// ReferenceIDEqualFold applies the EqualFold predicate on the "reference_id" field.
+func ReferenceIDEqualFold(v string) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldReferenceID), v))
+	})
+}
+
+// ReferenceIDContainsFold applies the ContainsFold predicate on the "reference_id" field.
+func ReferenceIDContainsFold(v string) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldReferenceID), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.StockMovement {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockMovement(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.StockMovement {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockMovement(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// HasProduct applies the HasEdge predicate on the "product" edge.
+func HasProduct() predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ProductTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProductTable, ProductColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProductWith applies the HasEdge predicate on the "product" edge with a given conditions (other predicates).
+func HasProductWith(preds ...predicate.Product) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ProductInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProductTable, ProductColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.StockMovement) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.StockMovement) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.StockMovement) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
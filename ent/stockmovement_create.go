@@ -0,0 +1,379 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/law-a-1/product-service/ent/product"
+	"github.com/law-a-1/product-service/ent/stockmovement"
+)
+
+// StockMovementCreate is the builder for creating a StockMovement entity.
+type StockMovementCreate struct {
+	config
+	mutation *StockMovementMutation
+	hooks    []Hook
+}
+
+// SetProductID sets the "product_id" field.
+func (smc *StockMovementCreate) SetProductID(i int) *StockMovementCreate {
+	smc.mutation.SetProductID(i)
+	return smc
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (smc *StockMovementCreate) SetNillableProductID(i *int) *StockMovementCreate {
+	if i != nil {
+		smc.SetProductID(*i)
+	}
+	return smc
+}
+
+// SetDelta sets the "delta" field.
+func (smc *StockMovementCreate) SetDelta(i int) *StockMovementCreate {
+	smc.mutation.SetDelta(i)
+	return smc
+}
+
+// SetStockAfter sets the "stock_after" field.
+func (smc *StockMovementCreate) SetStockAfter(i int) *StockMovementCreate {
+	smc.mutation.SetStockAfter(i)
+	return smc
+}
+
+// SetReason sets the "reason" field.
+func (smc *StockMovementCreate) SetReason(s stockmovement.Reason) *StockMovementCreate {
+	smc.mutation.SetReason(s)
+	return smc
+}
+
+// SetActor sets the "actor" field.
+func (smc *StockMovementCreate) SetActor(s string) *StockMovementCreate {
+	smc.mutation.SetActor(s)
+	return smc
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (smc *StockMovementCreate) SetNillableActor(s *string) *StockMovementCreate {
+	if s != nil {
+		smc.SetActor(*s)
+	}
+	return smc
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (smc *StockMovementCreate) SetReferenceID(s string) *StockMovementCreate {
+	smc.mutation.SetReferenceID(s)
+	return smc
+}
+
+// SetNillableReferenceID sets the "reference_id" field if the given value is not nil.
+func (smc *StockMovementCreate) SetNillableReferenceID(s *string) *StockMovementCreate {
+	if s != nil {
+		smc.SetReferenceID(*s)
+	}
+	return smc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (smc *StockMovementCreate) SetCreatedAt(t time.Time) *StockMovementCreate {
+	smc.mutation.SetCreatedAt(t)
+	return smc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (smc *StockMovementCreate) SetNillableCreatedAt(t *time.Time) *StockMovementCreate {
+	if t != nil {
+		smc.SetCreatedAt(*t)
+	}
+	return smc
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (smc *StockMovementCreate) SetProduct(p *Product) *StockMovementCreate {
+	return smc.SetProductID(p.ID)
+}
+
+// Mutation returns the StockMovementMutation object of the builder.
+func (smc *StockMovementCreate) Mutation() *StockMovementMutation {
+	return smc.mutation
+}
+
+// Save creates the StockMovement in the database.
+func (smc *StockMovementCreate) Save(ctx context.Context) (*StockMovement, error) {
+	var (
+		err  error
+		node *StockMovement
+	)
+	smc.defaults()
+	if len(smc.hooks) == 0 {
+		if err = smc.check(); err != nil {
+			return nil, err
+		}
+		node, err = smc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*StockMovementMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = smc.check(); err != nil {
+				return nil, err
+			}
+			smc.mutation = mutation
+			if node, err = smc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(smc.hooks) - 1; i >= 0; i-- {
+			if smc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = smc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, smc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (smc *StockMovementCreate) SaveX(ctx context.Context) *StockMovement {
+	v, err := smc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (smc *StockMovementCreate) Exec(ctx context.Context) error {
+	_, err := smc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smc *StockMovementCreate) ExecX(ctx context.Context) {
+	if err := smc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (smc *StockMovementCreate) defaults() {
+	if _, ok := smc.mutation.CreatedAt(); !ok {
+		v := stockmovement.DefaultCreatedAt()
+		smc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (smc *StockMovementCreate) check() error {
+	if _, ok := smc.mutation.Delta(); !ok {
+		return &ValidationError{Name: "delta", err: errors.New(`ent: missing required field "StockMovement.delta"`)}
+	}
+	if _, ok := smc.mutation.StockAfter(); !ok {
+		return &ValidationError{Name: "stock_after", err: errors.New(`ent: missing required field "StockMovement.stock_after"`)}
+	}
+	if _, ok := smc.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "StockMovement.reason"`)}
+	}
+	if v, ok := smc.mutation.Reason(); ok {
+		if err := stockmovement.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "StockMovement.reason": %w`, err)}
+		}
+	}
+	if _, ok := smc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "StockMovement.created_at"`)}
+	}
+	return nil
+}
+
+func (smc *StockMovementCreate) sqlSave(ctx context.Context) (*StockMovement, error) {
+	_node, _spec := smc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, smc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (smc *StockMovementCreate) createSpec() (*StockMovement, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StockMovement{config: smc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: stockmovement.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: stockmovement.FieldID,
+			},
+		}
+	)
+	if value, ok := smc.mutation.Delta(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockmovement.FieldDelta,
+		})
+		_node.Delta = value
+	}
+	if value, ok := smc.mutation.StockAfter(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockmovement.FieldStockAfter,
+		})
+		_node.StockAfter = value
+	}
+	if value, ok := smc.mutation.Reason(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: stockmovement.FieldReason,
+		})
+		_node.Reason = value
+	}
+	if value, ok := smc.mutation.Actor(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: stockmovement.FieldActor,
+		})
+		_node.Actor = value
+	}
+	if value, ok := smc.mutation.ReferenceID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: stockmovement.FieldReferenceID,
+		})
+		_node.ReferenceID = value
+	}
+	if value, ok := smc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: stockmovement.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := smc.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.ProductTable,
+			Columns: []string{stockmovement.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: product.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProductID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// StockMovementCreateBulk is the builder for creating many StockMovement entities in bulk.
+type StockMovementCreateBulk struct {
+	config
+	builders []*StockMovementCreate
+}
+
+// Save creates the StockMovement entities in the database.
+func (smcb *StockMovementCreateBulk) Save(ctx context.Context) ([]*StockMovement, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(smcb.builders))
+	nodes := make([]*StockMovement, len(smcb.builders))
+	mutators := make([]Mutator, len(smcb.builders))
+	for i := range smcb.builders {
+		func(i int, root context.Context) {
+			builder := smcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StockMovementMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, smcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, smcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, smcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (smcb *StockMovementCreateBulk) SaveX(ctx context.Context) []*StockMovement {
+	v, err := smcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (smcb *StockMovementCreateBulk) Exec(ctx context.Context) error {
+	_, err := smcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smcb *StockMovementCreateBulk) ExecX(ctx context.Context) {
+	if err := smcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,111 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/law-a-1/product-service/ent/predicate"
+	"github.com/law-a-1/product-service/ent/stockmovement"
+)
+
+// StockMovementDelete is the builder for deleting a StockMovement entity.
+type StockMovementDelete struct {
+	config
+	hooks    []Hook
+	mutation *StockMovementMutation
+}
+
+// Where appends a list predicates to the StockMovementDelete builder.
+func (smd *StockMovementDelete) Where(ps ...predicate.StockMovement) *StockMovementDelete {
+	smd.mutation.Where(ps...)
+	return smd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (smd *StockMovementDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(smd.hooks) == 0 {
+		affected, err = smd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*StockMovementMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			smd.mutation = mutation
+			affected, err = smd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(smd.hooks) - 1; i >= 0; i-- {
+			if smd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = smd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, smd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smd *StockMovementDelete) ExecX(ctx context.Context) int {
+	n, err := smd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (smd *StockMovementDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: stockmovement.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: stockmovement.FieldID,
+			},
+		},
+	}
+	if ps := smd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, smd.driver, _spec)
+}
+
+// StockMovementDeleteOne is the builder for deleting a single StockMovement entity.
+type StockMovementDeleteOne struct {
+	smd *StockMovementDelete
+}
+
+// Exec executes the deletion query.
+func (smdo *StockMovementDeleteOne) Exec(ctx context.Context) error {
+	n, err := smdo.smd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{stockmovement.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smdo *StockMovementDeleteOne) ExecX(ctx context.Context) {
+	smdo.smd.ExecX(ctx)
+}
@@ -0,0 +1,633 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/law-a-1/product-service/ent/predicate"
+	"github.com/law-a-1/product-service/ent/product"
+	"github.com/law-a-1/product-service/ent/stockmovement"
+)
+
+// StockMovementQuery is the builder for querying StockMovement entities.
+type StockMovementQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.StockMovement
+	// eager-loading edges.
+	withProduct *ProductQuery
+	modifiers   []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the StockMovementQuery builder.
+func (smq *StockMovementQuery) Where(ps ...predicate.StockMovement) *StockMovementQuery {
+	smq.predicates = append(smq.predicates, ps...)
+	return smq
+}
+
+// Limit adds a limit step to the query.
+func (smq *StockMovementQuery) Limit(limit int) *StockMovementQuery {
+	smq.limit = &limit
+	return smq
+}
+
+// Offset adds an offset step to the query.
+func (smq *StockMovementQuery) Offset(offset int) *StockMovementQuery {
+	smq.offset = &offset
+	return smq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (smq *StockMovementQuery) Unique(unique bool) *StockMovementQuery {
+	smq.unique = &unique
+	return smq
+}
+
+// Order adds an order step to the query.
+func (smq *StockMovementQuery) Order(o ...OrderFunc) *StockMovementQuery {
+	smq.order = append(smq.order, o...)
+	return smq
+}
+
+// QueryProduct chains the current query on the "product" edge.
+func (smq *StockMovementQuery) QueryProduct() *ProductQuery {
+	query := &ProductQuery{config: smq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := smq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := smq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(stockmovement.Table, stockmovement.FieldID, selector),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, stockmovement.ProductTable, stockmovement.ProductColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(smq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first StockMovement entity from the query.
+// Returns a *NotFoundError when no StockMovement was found.
+func (smq *StockMovementQuery) First(ctx context.Context) (*StockMovement, error) {
+	nodes, err := smq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{stockmovement.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (smq *StockMovementQuery) FirstX(ctx context.Context) *StockMovement {
+	node, err := smq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first StockMovement ID from the query.
+// Returns a *NotFoundError when no StockMovement ID was found.
+func (smq *StockMovementQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = smq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{stockmovement.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (smq *StockMovementQuery) FirstIDX(ctx context.Context) int {
+	id, err := smq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single StockMovement entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one StockMovement entity is found.
+// Returns a *NotFoundError when no StockMovement entities are found.
+func (smq *StockMovementQuery) Only(ctx context.Context) (*StockMovement, error) {
+	nodes, err := smq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{stockmovement.Label}
+	default:
+		return nil, &NotSingularError{stockmovement.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (smq *StockMovementQuery) OnlyX(ctx context.Context) *StockMovement {
+	node, err := smq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only StockMovement ID in the query.
+// Returns a *NotSingularError when more than one StockMovement ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (smq *StockMovementQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = smq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{stockmovement.Label}
+	default:
+		err = &NotSingularError{stockmovement.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (smq *StockMovementQuery) OnlyIDX(ctx context.Context) int {
+	id, err := smq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of StockMovements.
+func (smq *StockMovementQuery) All(ctx context.Context) ([]*StockMovement, error) {
+	if err := smq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return smq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (smq *StockMovementQuery) AllX(ctx context.Context) []*StockMovement {
+	nodes, err := smq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of StockMovement IDs.
+func (smq *StockMovementQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := smq.Select(stockmovement.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (smq *StockMovementQuery) IDsX(ctx context.Context) []int {
+	ids, err := smq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (smq *StockMovementQuery) Count(ctx context.Context) (int, error) {
+	if err := smq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return smq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (smq *StockMovementQuery) CountX(ctx context.Context) int {
+	count, err := smq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (smq *StockMovementQuery) Exist(ctx context.Context) (bool, error) {
+	if err := smq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return smq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (smq *StockMovementQuery) ExistX(ctx context.Context) bool {
+	exist, err := smq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the StockMovementQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (smq *StockMovementQuery) Clone() *StockMovementQuery {
+	if smq == nil {
+		return nil
+	}
+	return &StockMovementQuery{
+		config:      smq.config,
+		limit:       smq.limit,
+		offset:      smq.offset,
+		order:       append([]OrderFunc{}, smq.order...),
+		predicates:  append([]predicate.StockMovement{}, smq.predicates...),
+		withProduct: smq.withProduct.Clone(),
+		// clone intermediate query.
+		sql:    smq.sql.Clone(),
+		path:   smq.path,
+		unique: smq.unique,
+	}
+}
+
+// WithProduct tells the query-builder to eager-load the nodes that are connected to
+// the "product" edge. The optional arguments are used to configure the query builder of the edge.
+func (smq *StockMovementQuery) WithProduct(opts ...func(*ProductQuery)) *StockMovementQuery {
+	query := &ProductQuery{config: smq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	smq.withProduct = query
+	return smq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ProductID int `json:"product_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.StockMovement.Query().
+//		GroupBy(stockmovement.FieldProductID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (smq *StockMovementQuery) GroupBy(field string, fields ...string) *StockMovementGroupBy {
+	grbuild := &StockMovementGroupBy{config: smq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := smq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return smq.sqlQuery(ctx), nil
+	}
+	grbuild.label = stockmovement.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ProductID int `json:"product_id,omitempty"`
+//	}
+//
+//	client.StockMovement.Query().
+//		Select(stockmovement.FieldProductID).
+//		Scan(ctx, &v)
+//
+func (smq *StockMovementQuery) Select(fields ...string) *StockMovementSelect {
+	smq.fields = append(smq.fields, fields...)
+	selbuild := &StockMovementSelect{StockMovementQuery: smq}
+	selbuild.label = stockmovement.Label
+	selbuild.flds, selbuild.scan = &smq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (smq *StockMovementQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range smq.fields {
+		if !stockmovement.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if smq.path != nil {
+		prev, err := smq.path(ctx)
+		if err != nil {
+			return err
+		}
+		smq.sql = prev
+	}
+	return nil
+}
+
+func (smq *StockMovementQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*StockMovement, error) {
+	var (
+		nodes       = []*StockMovement{}
+		_spec       = smq.querySpec()
+		loadedTypes = [1]bool{
+			smq.withProduct != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*StockMovement).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &StockMovement{config: smq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(smq.modifiers) > 0 {
+		_spec.Modifiers = smq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, smq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := smq.withProduct; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*StockMovement)
+		for i := range nodes {
+			fk := nodes[i].ProductID
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(product.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "product_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Product = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (smq *StockMovementQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := smq.querySpec()
+	if len(smq.modifiers) > 0 {
+		_spec.Modifiers = smq.modifiers
+	}
+	_spec.Node.Columns = smq.fields
+	if len(smq.fields) > 0 {
+		_spec.Unique = smq.unique != nil && *smq.unique
+	}
+	return sqlgraph.CountNodes(ctx, smq.driver, _spec)
+}
+
+func (smq *StockMovementQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := smq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (smq *StockMovementQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   stockmovement.Table,
+			Columns: stockmovement.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: stockmovement.FieldID,
+			},
+		},
+		From:   smq.sql,
+		Unique: true,
+	}
+	if unique := smq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := smq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, stockmovement.FieldID)
+		for i := range fields {
+			if fields[i] != stockmovement.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := smq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := smq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := smq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := smq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (smq *StockMovementQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(smq.driver.Dialect())
+	t1 := builder.Table(stockmovement.Table)
+	columns := smq.fields
+	if len(columns) == 0 {
+		columns = stockmovement.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if smq.sql != nil {
+		selector = smq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if smq.unique != nil && *smq.unique {
+		selector.Distinct()
+	}
+	for _, m := range smq.modifiers {
+		m(selector)
+	}
+	for _, p := range smq.predicates {
+		p(selector)
+	}
+	for _, p := range smq.order {
+		p(selector)
+	}
+	if offset := smq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := smq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (smq *StockMovementQuery) ForUpdate(opts ...sql.LockOption) *StockMovementQuery {
+	if smq.driver.Dialect() == dialect.Postgres {
+		smq.Unique(false)
+	}
+	smq.modifiers = append(smq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return smq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (smq *StockMovementQuery) ForShare(opts ...sql.LockOption) *StockMovementQuery {
+	if smq.driver.Dialect() == dialect.Postgres {
+		smq.Unique(false)
+	}
+	smq.modifiers = append(smq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return smq
+}
+
+// StockMovementGroupBy is the group-by builder for StockMovement entities.
+type StockMovementGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (smgb *StockMovementGroupBy) Aggregate(fns ...AggregateFunc) *StockMovementGroupBy {
+	smgb.fns = append(smgb.fns, fns...)
+	return smgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (smgb *StockMovementGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := smgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	smgb.sql = query
+	return smgb.sqlScan(ctx, v)
+}
+
+func (smgb *StockMovementGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range smgb.fields {
+		if !stockmovement.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := smgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := smgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (smgb *StockMovementGroupBy) sqlQuery() *sql.Selector {
+	selector := smgb.sql.Select()
+	aggregation := make([]string, 0, len(smgb.fns))
+	for _, fn := range smgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(smgb.fields)+len(smgb.fns))
+		for _, f := range smgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(smgb.fields...)...)
+}
+
+// StockMovementSelect is the builder for selecting fields of StockMovement entities.
+type StockMovementSelect struct {
+	*StockMovementQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sms *StockMovementSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := sms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	sms.sql = sms.StockMovementQuery.sqlQuery(ctx)
+	return sms.sqlScan(ctx, v)
+}
+
+func (sms *StockMovementSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := sms.sql.Query()
+	if err := sms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,670 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/law-a-1/product-service/ent/predicate"
+	"github.com/law-a-1/product-service/ent/product"
+	"github.com/law-a-1/product-service/ent/stockmovement"
+)
+
+// StockMovementUpdate is the builder for updating StockMovement entities.
+type StockMovementUpdate struct {
+	config
+	hooks    []Hook
+	mutation *StockMovementMutation
+}
+
+// Where appends a list predicates to the StockMovementUpdate builder.
+func (smu *StockMovementUpdate) Where(ps ...predicate.StockMovement) *StockMovementUpdate {
+	smu.mutation.Where(ps...)
+	return smu
+}
+
+// SetProductID sets the "product_id" field.
+func (smu *StockMovementUpdate) SetProductID(i int) *StockMovementUpdate {
+	smu.mutation.SetProductID(i)
+	return smu
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (smu *StockMovementUpdate) SetNillableProductID(i *int) *StockMovementUpdate {
+	if i != nil {
+		smu.SetProductID(*i)
+	}
+	return smu
+}
+
+// ClearProductID clears the value of the "product_id" field.
+func (smu *StockMovementUpdate) ClearProductID() *StockMovementUpdate {
+	smu.mutation.ClearProductID()
+	return smu
+}
+
+// SetDelta sets the "delta" field.
+func (smu *StockMovementUpdate) SetDelta(i int) *StockMovementUpdate {
+	smu.mutation.ResetDelta()
+	smu.mutation.SetDelta(i)
+	return smu
+}
+
+// AddDelta adds i to the "delta" field.
+func (smu *StockMovementUpdate) AddDelta(i int) *StockMovementUpdate {
+	smu.mutation.AddDelta(i)
+	return smu
+}
+
+// SetStockAfter sets the "stock_after" field.
+func (smu *StockMovementUpdate) SetStockAfter(i int) *StockMovementUpdate {
+	smu.mutation.ResetStockAfter()
+	smu.mutation.SetStockAfter(i)
+	return smu
+}
+
+// AddStockAfter adds i to the "stock_after" field.
+func (smu *StockMovementUpdate) AddStockAfter(i int) *StockMovementUpdate {
+	smu.mutation.AddStockAfter(i)
+	return smu
+}
+
+// SetReason sets the "reason" field.
+func (smu *StockMovementUpdate) SetReason(s stockmovement.Reason) *StockMovementUpdate {
+	smu.mutation.SetReason(s)
+	return smu
+}
+
+// SetActor sets the "actor" field.
+func (smu *StockMovementUpdate) SetActor(s string) *StockMovementUpdate {
+	smu.mutation.SetActor(s)
+	return smu
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (smu *StockMovementUpdate) SetNillableActor(s *string) *StockMovementUpdate {
+	if s != nil {
+		smu.SetActor(*s)
+	}
+	return smu
+}
+
+// ClearActor clears the value of the "actor" field.
+func (smu *StockMovementUpdate) ClearActor() *StockMovementUpdate {
+	smu.mutation.ClearActor()
+	return smu
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (smu *StockMovementUpdate) SetReferenceID(s string) *StockMovementUpdate {
+	smu.mutation.SetReferenceID(s)
+	return smu
+}
+
+// SetNillableReferenceID sets the "reference_id" field if the given value is not nil.
+func (smu *StockMovementUpdate) SetNillableReferenceID(s *string) *StockMovementUpdate {
+	if s != nil {
+		smu.SetReferenceID(*s)
+	}
+	return smu
+}
+
+// ClearReferenceID clears the value of the "reference_id" field.
+func (smu *StockMovementUpdate) ClearReferenceID() *StockMovementUpdate {
+	smu.mutation.ClearReferenceID()
+	return smu
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (smu *StockMovementUpdate) SetProduct(p *Product) *StockMovementUpdate {
+	return smu.SetProductID(p.ID)
+}
+
+// Mutation returns the StockMovementMutation object of the builder.
+func (smu *StockMovementUpdate) Mutation() *StockMovementMutation {
+	return smu.mutation
+}
+
+// ClearProduct clears the "product" edge to the Product entity.
+func (smu *StockMovementUpdate) ClearProduct() *StockMovementUpdate {
+	smu.mutation.ClearProduct()
+	return smu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (smu *StockMovementUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(smu.hooks) == 0 {
+		if err = smu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = smu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*StockMovementMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = smu.check(); err != nil {
+				return 0, err
+			}
+			smu.mutation = mutation
+			affected, err = smu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(smu.hooks) - 1; i >= 0; i-- {
+			if smu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = smu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, smu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (smu *StockMovementUpdate) SaveX(ctx context.Context) int {
+	affected, err := smu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (smu *StockMovementUpdate) Exec(ctx context.Context) error {
+	_, err := smu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smu *StockMovementUpdate) ExecX(ctx context.Context) {
+	if err := smu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (smu *StockMovementUpdate) check() error {
+	if v, ok := smu.mutation.Reason(); ok {
+		if err := stockmovement.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "StockMovement.reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (smu *StockMovementUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   stockmovement.Table,
+			Columns: stockmovement.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: stockmovement.FieldID,
+			},
+		},
+	}
+	if ps := smu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := smu.mutation.Delta(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockmovement.FieldDelta,
+		})
+	}
+	if value, ok := smu.mutation.AddedDelta(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockmovement.FieldDelta,
+		})
+	}
+	if value, ok := smu.mutation.StockAfter(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockmovement.FieldStockAfter,
+		})
+	}
+	if value, ok := smu.mutation.AddedStockAfter(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockmovement.FieldStockAfter,
+		})
+	}
+	if value, ok := smu.mutation.Reason(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: stockmovement.FieldReason,
+		})
+	}
+	if value, ok := smu.mutation.Actor(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: stockmovement.FieldActor,
+		})
+	}
+	if smu.mutation.ActorCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: stockmovement.FieldActor,
+		})
+	}
+	if value, ok := smu.mutation.ReferenceID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: stockmovement.FieldReferenceID,
+		})
+	}
+	if smu.mutation.ReferenceIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: stockmovement.FieldReferenceID,
+		})
+	}
+	if smu.mutation.ProductCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.ProductTable,
+			Columns: []string{stockmovement.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: product.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := smu.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.ProductTable,
+			Columns: []string{stockmovement.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: product.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, smu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{stockmovement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// StockMovementUpdateOne is the builder for updating a single StockMovement entity.
+type StockMovementUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *StockMovementMutation
+}
+
+// SetProductID sets the "product_id" field.
+func (smuo *StockMovementUpdateOne) SetProductID(i int) *StockMovementUpdateOne {
+	smuo.mutation.SetProductID(i)
+	return smuo
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (smuo *StockMovementUpdateOne) SetNillableProductID(i *int) *StockMovementUpdateOne {
+	if i != nil {
+		smuo.SetProductID(*i)
+	}
+	return smuo
+}
+
+// ClearProductID clears the value of the "product_id" field.
+func (smuo *StockMovementUpdateOne) ClearProductID() *StockMovementUpdateOne {
+	smuo.mutation.ClearProductID()
+	return smuo
+}
+
+// SetDelta sets the "delta" field.
+func (smuo *StockMovementUpdateOne) SetDelta(i int) *StockMovementUpdateOne {
+	smuo.mutation.ResetDelta()
+	smuo.mutation.SetDelta(i)
+	return smuo
+}
+
+// AddDelta adds i to the "delta" field.
+func (smuo *StockMovementUpdateOne) AddDelta(i int) *StockMovementUpdateOne {
+	smuo.mutation.AddDelta(i)
+	return smuo
+}
+
+// SetStockAfter sets the "stock_after" field.
+func (smuo *StockMovementUpdateOne) SetStockAfter(i int) *StockMovementUpdateOne {
+	smuo.mutation.ResetStockAfter()
+	smuo.mutation.SetStockAfter(i)
+	return smuo
+}
+
+// AddStockAfter adds i to the "stock_after" field.
+func (smuo *StockMovementUpdateOne) AddStockAfter(i int) *StockMovementUpdateOne {
+	smuo.mutation.AddStockAfter(i)
+	return smuo
+}
+
+// SetReason sets the "reason" field.
+func (smuo *StockMovementUpdateOne) SetReason(s stockmovement.Reason) *StockMovementUpdateOne {
+	smuo.mutation.SetReason(s)
+	return smuo
+}
+
+// SetActor sets the "actor" field.
+func (smuo *StockMovementUpdateOne) SetActor(s string) *StockMovementUpdateOne {
+	smuo.mutation.SetActor(s)
+	return smuo
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (smuo *StockMovementUpdateOne) SetNillableActor(s *string) *StockMovementUpdateOne {
+	if s != nil {
+		smuo.SetActor(*s)
+	}
+	return smuo
+}
+
+// ClearActor clears the value of the "actor" field.
+func (smuo *StockMovementUpdateOne) ClearActor() *StockMovementUpdateOne {
+	smuo.mutation.ClearActor()
+	return smuo
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (smuo *StockMovementUpdateOne) SetReferenceID(s string) *StockMovementUpdateOne {
+	smuo.mutation.SetReferenceID(s)
+	return smuo
+}
+
+// SetNillableReferenceID sets the "reference_id" field if the given value is not nil.
+func (smuo *StockMovementUpdateOne) SetNillableReferenceID(s *string) *StockMovementUpdateOne {
+	if s != nil {
+		smuo.SetReferenceID(*s)
+	}
+	return smuo
+}
+
+// ClearReferenceID clears the value of the "reference_id" field.
+func (smuo *StockMovementUpdateOne) ClearReferenceID() *StockMovementUpdateOne {
+	smuo.mutation.ClearReferenceID()
+	return smuo
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (smuo *StockMovementUpdateOne) SetProduct(p *Product) *StockMovementUpdateOne {
+	return smuo.SetProductID(p.ID)
+}
+
+// Mutation returns the StockMovementMutation object of the builder.
+func (smuo *StockMovementUpdateOne) Mutation() *StockMovementMutation {
+	return smuo.mutation
+}
+
+// ClearProduct clears the "product" edge to the Product entity.
+func (smuo *StockMovementUpdateOne) ClearProduct() *StockMovementUpdateOne {
+	smuo.mutation.ClearProduct()
+	return smuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (smuo *StockMovementUpdateOne) Select(field string, fields ...string) *StockMovementUpdateOne {
+	smuo.fields = append([]string{field}, fields...)
+	return smuo
+}
+
+// Save executes the query and returns the updated StockMovement entity.
+func (smuo *StockMovementUpdateOne) Save(ctx context.Context) (*StockMovement, error) {
+	var (
+		err  error
+		node *StockMovement
+	)
+	if len(smuo.hooks) == 0 {
+		if err = smuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = smuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*StockMovementMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = smuo.check(); err != nil {
+				return nil, err
+			}
+			smuo.mutation = mutation
+			node, err = smuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(smuo.hooks) - 1; i >= 0; i-- {
+			if smuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = smuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, smuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (smuo *StockMovementUpdateOne) SaveX(ctx context.Context) *StockMovement {
+	node, err := smuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (smuo *StockMovementUpdateOne) Exec(ctx context.Context) error {
+	_, err := smuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smuo *StockMovementUpdateOne) ExecX(ctx context.Context) {
+	if err := smuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (smuo *StockMovementUpdateOne) check() error {
+	if v, ok := smuo.mutation.Reason(); ok {
+		if err := stockmovement.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "StockMovement.reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (smuo *StockMovementUpdateOne) sqlSave(ctx context.Context) (_node *StockMovement, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   stockmovement.Table,
+			Columns: stockmovement.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: stockmovement.FieldID,
+			},
+		},
+	}
+	id, ok := smuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "StockMovement.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := smuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, stockmovement.FieldID)
+		for _, f := range fields {
+			if !stockmovement.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != stockmovement.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := smuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := smuo.mutation.Delta(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockmovement.FieldDelta,
+		})
+	}
+	if value, ok := smuo.mutation.AddedDelta(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockmovement.FieldDelta,
+		})
+	}
+	if value, ok := smuo.mutation.StockAfter(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockmovement.FieldStockAfter,
+		})
+	}
+	if value, ok := smuo.mutation.AddedStockAfter(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockmovement.FieldStockAfter,
+		})
+	}
+	if value, ok := smuo.mutation.Reason(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: stockmovement.FieldReason,
+		})
+	}
+	if value, ok := smuo.mutation.Actor(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: stockmovement.FieldActor,
+		})
+	}
+	if smuo.mutation.ActorCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: stockmovement.FieldActor,
+		})
+	}
+	if value, ok := smuo.mutation.ReferenceID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: stockmovement.FieldReferenceID,
+		})
+	}
+	if smuo.mutation.ReferenceIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: stockmovement.FieldReferenceID,
+		})
+	}
+	if smuo.mutation.ProductCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.ProductTable,
+			Columns: []string{stockmovement.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: product.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := smuo.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.ProductTable,
+			Columns: []string{stockmovement.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: product.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &StockMovement{config: smuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, smuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{stockmovement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
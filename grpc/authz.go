@@ -0,0 +1,255 @@
+package grpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/law-a-1/product-service/auth"
+	"github.com/law-a-1/product-service/stock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// methodPermissions lists the permission each protected RPC requires from
+// users. RPCs not listed only read the catalog and are open to every caller.
+var methodPermissions = map[string]auth.Permission{
+	"/Product/DecreaseStock":      auth.StockDecrease,
+	"/Product/DecreaseStockBatch": auth.StockDecrease,
+	"/Product/Reserve":            auth.StockReserve,
+	"/Product/ConfirmReservation": auth.StockReserve,
+	"/Product/CancelReservation":  auth.StockReserve,
+	"/Product/IncreaseStock":      auth.StockIncrease,
+	"/Product/AdjustStock":        auth.StockAdjust,
+}
+
+// Caller is a service allowed to call the Product service. It is identified
+// by its bearer token, or by its name appearing in the verified mTLS client
+// certificate, and may only invoke the listed methods.
+type Caller struct {
+	Name string `json:"name"`
+	// Token is the caller's bearer token; ${VAR} references are expanded
+	// from the environment when loading. Callers without a token can only
+	// authenticate with a client certificate.
+	Token string `json:"token"`
+	// Methods are RPC names such as "DecreaseStock", or "*" for all.
+	Methods []string `json:"methods"`
+
+	tokenHash [sha256.Size]byte
+}
+
+func (c *Caller) allowed(method string) bool {
+	name := strings.TrimPrefix(method, "/Product/")
+	for _, m := range c.Methods {
+		if m == "*" || m == name || m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// LoadCallers reads a callers file of the form
+//
+//	{"callers": [{"name": "order-service", "token": "${ORDER_SERVICE_TOKEN}", "methods": ["DecreaseStock"]}]}
+func LoadCallers(path string) ([]Caller, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading callers: %w", err)
+	}
+	var file struct {
+		Callers []Caller `json:"callers"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parsing callers: %w", err)
+	}
+	for i := range file.Callers {
+		c := &file.Callers[i]
+		if c.Name == "" {
+			return nil, fmt.Errorf("caller %d has no name", i)
+		}
+		if c.Token != "" {
+			token := os.ExpandEnv(c.Token)
+			if token == "" {
+				// An unset variable would silently lock the caller out.
+				return nil, fmt.Errorf("caller %q: token %s is empty", c.Name, c.Token)
+			}
+			c.Token = token
+		}
+	}
+	return file.Callers, nil
+}
+
+// WithAuthorization authenticates users by the bearer token sent in the
+// "authorization" metadata and checks their role against policy.
+func WithAuthorization(authenticator auth.Authenticator, policy *auth.Policy) Option {
+	return func(s *Server) {
+		s.authenticator = authenticator
+		s.policy = policy
+	}
+}
+
+// WithCallers sets the services allowed to call the Product service.
+func WithCallers(callers []Caller) Option {
+	return func(s *Server) {
+		s.callers = make([]Caller, len(callers))
+		for i, c := range callers {
+			if c.Token != "" {
+				c.tokenHash = sha256.Sum256([]byte(c.Token))
+			}
+			s.callers[i] = c
+		}
+	}
+}
+
+// authorize identifies the caller of method and checks it may invoke it. A
+// service is identified by its client certificate or its token and must have
+// the method in its allowlist; any other bearer token is authenticated as a
+// user, who needs the permission of protected methods. Anonymous callers may
+// only use unprotected methods, and the health service is open to every
+// caller. The returned context records the caller as the actor of stock
+// changes.
+func (s Server) authorize(ctx context.Context, method string) (context.Context, error) {
+	if strings.HasPrefix(method, healthPrefix) {
+		return ctx, nil
+	}
+	if c := s.certificateCaller(ctx); c != nil {
+		return s.authorizeCaller(ctx, c, method)
+	}
+
+	token := bearerToken(ctx)
+	if token != "" {
+		if c := s.tokenCaller(token); c != nil {
+			return s.authorizeCaller(ctx, c, method)
+		}
+	}
+
+	perm, protected := methodPermissions[method]
+	if token == "" {
+		if protected {
+			return nil, status.Error(codes.Unauthenticated, "missing credentials")
+		}
+		return ctx, nil
+	}
+	if s.authenticator == nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	user, err := s.authenticator.Authenticate(ctx, token)
+	if err != nil {
+		if errors.Is(err, auth.ErrUnavailable) {
+			s.log(ctx).Warnf("failed to authenticate: %v", err)
+			return nil, status.Error(codes.Unavailable, "authentication unavailable")
+		}
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+	if protected && (s.policy == nil || !s.policy.Allowed(user.Role, perm)) {
+		return nil, status.Errorf(codes.PermissionDenied, "role %q is not granted %s", user.Role, perm)
+	}
+	ctx = s.withCaller(ctx, "user_id", user.ID)
+	ctx = context.WithValue(ctx, callerKey{}, fmt.Sprintf("user:%d", user.ID))
+	return stock.WithActor(ctx, user.Username), nil
+}
+
+func (s Server) authorizeCaller(ctx context.Context, c *Caller, method string) (context.Context, error) {
+	if !c.allowed(method) {
+		s.log(ctx).Warnf("caller %s denied %s", c.Name, method)
+		return nil, status.Errorf(codes.PermissionDenied, "caller %q may not call %s", c.Name, method)
+	}
+	ctx = s.withCaller(ctx, "caller", c.Name)
+	ctx = context.WithValue(ctx, callerKey{}, "service:"+c.Name)
+	return stock.WithActor(ctx, "service:"+c.Name), nil
+}
+
+type callerKey struct{}
+
+// callerFrom returns the identity of the caller authorize established, e.g.
+// "service:orders" or "user:42", or "" for anonymous callers.
+func callerFrom(ctx context.Context) string {
+	caller, _ := ctx.Value(callerKey{}).(string)
+	return caller
+}
+
+// certificateCaller returns the caller named in the verified client
+// certificate of the connection, if any.
+func (s Server) certificateCaller(ctx context.Context) *Caller {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	cert := info.State.VerifiedChains[0][0]
+
+	names := append([]string{cert.Subject.CommonName}, cert.DNSNames...)
+	for _, u := range cert.URIs {
+		names = append(names, u.String())
+	}
+	for i := range s.callers {
+		for _, name := range names {
+			if name != "" && name == s.callers[i].Name {
+				return &s.callers[i]
+			}
+		}
+	}
+	return nil
+}
+
+// tokenCaller returns the caller owning token, comparing in constant time.
+func (s Server) tokenCaller(token string) *Caller {
+	hash := sha256.Sum256([]byte(token))
+	var found *Caller
+	for i := range s.callers {
+		c := &s.callers[i]
+		if c.Token != "" && subtle.ConstantTimeCompare(hash[:], c.tokenHash[:]) == 1 {
+			found = c
+		}
+	}
+	return found
+}
+
+func (s Server) authUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := s.authorize(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (s Server) authStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := s.authorize(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, authorizedStream{ServerStream: ss, ctx: ctx})
+}
+
+// authorizedStream carries the context produced by an interceptor.
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s authorizedStream) Context() context.Context {
+	return s.ctx
+}
+
+func bearerToken(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, v := range md.Get("authorization") {
+		if token := strings.TrimPrefix(v, "Bearer "); token != v && token != "" {
+			return token
+		}
+	}
+	return ""
+}
@@ -0,0 +1,169 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/law-a-1/product-service/catalog"
+	"github.com/law-a-1/product-service/ent/schema"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const maxBatchGetProducts = 100
+
+func (s Server) GetProduct(ctx context.Context, in *GetProductRequest) (*GetProductResponse, error) {
+	item, err := catalog.Get(ctx, s.db, int(in.ID))
+	if err != nil {
+		if errors.Is(err, catalog.ErrNotFound) {
+			return &GetProductResponse{}, status.Errorf(codes.NotFound, "product with given ID not found")
+		}
+		s.log(ctx).Warnf("failed to get product: %v", err)
+		return &GetProductResponse{}, status.Errorf(codes.Internal, "failed to get product")
+	}
+
+	return &GetProductResponse{Product: productInfo(item)}, nil
+}
+
+func (s Server) BatchGetProducts(ctx context.Context, in *BatchGetProductsRequest) (*BatchGetProductsResponse, error) {
+	if len(in.IDs) > maxBatchGetProducts {
+		return &BatchGetProductsResponse{}, status.Errorf(codes.InvalidArgument, "at most %d IDs can be requested", maxBatchGetProducts)
+	}
+
+	ids := make([]int, 0, len(in.IDs))
+	for _, id := range in.IDs {
+		ids = append(ids, int(id))
+	}
+	items, err := catalog.GetMany(ctx, s.db, ids...)
+	if err != nil {
+		s.log(ctx).Warnf("failed to get products: %v", err)
+		return &BatchGetProductsResponse{}, status.Errorf(codes.Internal, "failed to get products")
+	}
+
+	found := make(map[int32]*ProductInfo, len(items))
+	for _, item := range items {
+		found[int32(item.ID)] = productInfo(item)
+	}
+	res := &BatchGetProductsResponse{}
+	seen := make(map[int32]bool, len(in.IDs))
+	for _, id := range in.IDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if p, ok := found[id]; ok {
+			res.Products = append(res.Products, p)
+		} else {
+			res.Missing = append(res.Missing, id)
+		}
+	}
+	return res, nil
+}
+
+func (s Server) ListProducts(ctx context.Context, in *ListProductsRequest) (*ListProductsResponse, error) {
+	if in.PageSize < 0 {
+		return &ListProductsResponse{}, status.Errorf(codes.InvalidArgument, "page size cannot be negative")
+	}
+	limit := int(in.PageSize)
+	if limit == 0 {
+		limit = catalog.DefaultPageSize
+	}
+
+	page, err := catalog.List(ctx, s.db, catalog.ListParams{Limit: limit, Cursor: in.PageToken})
+	if err != nil {
+		if errors.Is(err, catalog.ErrInvalidCursor) {
+			return &ListProductsResponse{}, status.Errorf(codes.InvalidArgument, "invalid page token")
+		}
+		s.log(ctx).Warnf("failed to list products: %v", err)
+		return &ListProductsResponse{}, status.Errorf(codes.Internal, "failed to list products")
+	}
+
+	res := &ListProductsResponse{
+		NextPageToken: page.NextCursor,
+		TotalCount:    int32(page.Total),
+	}
+	for _, item := range page.Items {
+		res.Products = append(res.Products, productInfo(item))
+	}
+	return res, nil
+}
+
+func productInfo(item catalog.Item) *ProductInfo {
+	var gallery []*Media
+	for _, m := range item.Edges.Media {
+		gallery = append(gallery, &Media{
+			ID:        int32(m.ID),
+			Kind:      string(m.Kind),
+			Url:       m.URL,
+			Variants:  imageVariants(m.Variants),
+			AltText:   m.AltText,
+			Position:  int32(m.Position),
+			IsPrimary: m.IsPrimary,
+		})
+	}
+
+	return &ProductInfo{
+		ID:            int32(item.ID),
+		Name:          item.Name,
+		Description:   item.Description,
+		Price:         int32(item.Price),
+		Stock:         int32(item.Stock),
+		Available:     int32(item.Available),
+		Image:         item.Image,
+		Video:         item.Video,
+		CreatedAt:     timestamppb.New(item.CreatedAt),
+		UpdatedAt:     timestamppb.New(item.UpdatedAt),
+		ImageVariants: imageVariants(item.ImageVariants),
+		Gallery:       gallery,
+	}
+}
+
+func imageVariants(variants map[string]schema.ImageVariant) map[string]*ImageVariant {
+	if len(variants) == 0 {
+		return nil
+	}
+	res := make(map[string]*ImageVariant, len(variants))
+	for size, v := range variants {
+		res[size] = &ImageVariant{
+			Url:    v.URL,
+			Webp:   v.WebP,
+			Width:  int32(v.Width),
+			Height: int32(v.Height),
+		}
+	}
+	return res
+}
+
+func (s Server) SearchProducts(ctx context.Context, in *SearchProductsRequest) (*SearchProductsResponse, error) {
+	if in.PageSize < 0 || in.Offset < 0 {
+		return &SearchProductsResponse{}, status.Errorf(codes.InvalidArgument, "page size and offset cannot be negative")
+	}
+
+	result, err := catalog.Search(ctx, s.db, catalog.SearchParams{
+		Query:  in.Query,
+		Limit:  int(in.PageSize),
+		Offset: int(in.Offset),
+	})
+	if err != nil {
+		if errors.Is(err, catalog.ErrEmptyQuery) {
+			return &SearchProductsResponse{}, status.Error(codes.InvalidArgument, err.Error())
+		}
+		s.log(ctx).Warnf("failed to search products: %v", err)
+		return &SearchProductsResponse{}, status.Errorf(codes.Internal, "failed to search products")
+	}
+
+	res := &SearchProductsResponse{
+		TotalCount: int32(result.Total),
+		Fuzzy:      result.Fuzzy,
+	}
+	for _, h := range result.Hits {
+		res.Hits = append(res.Hits, &SearchHit{
+			Product:              productInfo(h.Item),
+			Rank:                 float32(h.Rank),
+			NameHighlight:        h.NameHighlight,
+			DescriptionHighlight: h.DescriptionHighlight,
+		})
+	}
+	return res, nil
+}
@@ -2,66 +2,293 @@ package grpc
 
 import (
 	"context"
-	"github.com/law-a-1/product-service/ent/product"
+	"crypto/tls"
+	"errors"
+	"github.com/law-a-1/product-service/auth"
+	"github.com/law-a-1/product-service/ent/stockmovement"
+	"github.com/law-a-1/product-service/metrics"
+	"github.com/law-a-1/product-service/stock"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/status"
-	"log"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"net"
-	"os"
+	"time"
 
 	"github.com/law-a-1/product-service/ent"
 	"google.golang.org/grpc"
 )
 
 type Server struct {
-	grpcServer *grpc.Server
-	db         *ent.Client
-	logger     *zap.SugaredLogger
+	grpcServer           *grpc.Server
+	db                   *ent.Client
+	logger               *zap.SugaredLogger
+	idempotencyRetention time.Duration
+	idempotencyLease     time.Duration
+	authenticator        auth.Authenticator
+	policy               *auth.Policy
+	callers              []Caller
+	tlsConfig            *tls.Config
+	metrics              *metrics.Metrics
+	health               healthpb.HealthServer
 	UnimplementedProductServer
 }
 
-func NewServer(logger *zap.SugaredLogger, db *ent.Client) Server {
-	grpcServer := grpc.NewServer()
+// Option configures a Server.
+type Option func(*Server)
+
+// WithTLS serves gRPC over TLS. Client certificates verified by cfg identify
+// callers to the allowlist.
+func WithTLS(cfg *tls.Config) Option {
+	return func(s *Server) {
+		s.tlsConfig = cfg
+	}
+}
+
+// WithMetrics measures the calls served in m.
+func WithMetrics(m *metrics.Metrics) Option {
+	return func(s *Server) {
+		s.metrics = m
+	}
+}
+
+// WithHealth serves the gRPC health service h.
+func WithHealth(h healthpb.HealthServer) Option {
+	return func(s *Server) {
+		s.health = h
+	}
+}
+
+// WithIdempotencyRetention sets how long the outcome of a call is replayed for
+// retries with the same idempotency key.
+func WithIdempotencyRetention(d time.Duration) Option {
+	return func(s *Server) {
+		s.idempotencyRetention = d
+	}
+}
+
+// WithIdempotencyLease sets how long a call holds its idempotency key. A retry
+// arriving later takes the key over, so that a call lost with its replica
+// does not block the key for the whole retention window.
+func WithIdempotencyLease(d time.Duration) Option {
+	return func(s *Server) {
+		s.idempotencyLease = d
+	}
+}
+
+func NewServer(logger *zap.SugaredLogger, db *ent.Client, opts ...Option) Server {
 	s := Server{
-		grpcServer: grpcServer,
-		db:         db,
-		logger:     logger,
+		db:                   db,
+		logger:               logger,
+		idempotencyRetention: DefaultIdempotencyRetention,
+		idempotencyLease:     DefaultIdempotencyLease,
+	}
+	for _, opt := range opts {
+		opt(&s)
+	}
+
+	unary := []grpc.UnaryServerInterceptor{otelgrpc.UnaryServerInterceptor()}
+	stream := []grpc.StreamServerInterceptor{otelgrpc.StreamServerInterceptor()}
+	if s.metrics != nil {
+		unary = append(unary, s.metrics.UnaryServerInterceptor)
+		stream = append(stream, s.metrics.StreamServerInterceptor)
+	}
+	unary = append(unary, s.loggingUnaryInterceptor, s.authUnaryInterceptor, s.idempotencyInterceptor)
+	stream = append(stream, s.loggingStreamInterceptor, s.authStreamInterceptor)
+
+	serverOpts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	}
+	if s.tlsConfig != nil {
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(s.tlsConfig)))
+	}
+	s.grpcServer = grpc.NewServer(serverOpts...)
+	RegisterProductServer(s.grpcServer, s)
+	if s.health != nil {
+		healthpb.RegisterHealthServer(s.grpcServer, s.health)
 	}
-	RegisterProductServer(grpcServer, s)
 	return s
 }
 
-func (s Server) Start() error {
-	lis, err := net.Listen("tcp", ":"+os.Getenv("GRPC_PORT"))
+// Start serves gRPC on addr until Stop is called.
+func (s Server) Start(addr string) error {
+	lis, err := net.Listen("tcp", addr)
 	if err != nil {
 		return err
 	}
-	if err := s.grpcServer.Serve(lis); err != nil {
-		log.Fatalf("failed to serve: %v", err)
+	return s.grpcServer.Serve(lis)
+}
+
+// Stop stops accepting calls and waits for pending ones to finish. Calls still
+// running when ctx is done are cancelled.
+func (s Server) Stop(ctx context.Context) {
+	done := make(chan struct{})
+	go func() {
+		s.grpcServer.GracefulStop()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		s.grpcServer.Stop()
+		<-done
 	}
-	return nil
 }
 
 func (s Server) DecreaseStock(ctx context.Context, in *DecreaseStockRequest) (*DecreaseStockResponse, error) {
-	p, err := s.db.Product.Query().Where(product.ID(int(in.ID))).First(ctx)
+	ctx = stock.WithReference(ctx, in.ReferenceId)
+	remaining, err := stock.Decrease(ctx, s.db, int(in.ID), int(in.Amount))
 	if err != nil {
-		if ent.IsNotFound(err) {
-			s.logger.Warnf("product with given ID not found")
-			return &DecreaseStockResponse{}, status.Errorf(codes.NotFound, "product with given ID not found")
+		s.log(ctx).Warnf("failed to decrease stock: %v", err)
+		return &DecreaseStockResponse{}, stockError(err)
+	}
+
+	return &DecreaseStockResponse{Stock: int32(remaining)}, nil
+}
+
+func (s Server) DecreaseStockBatch(ctx context.Context, in *DecreaseStockBatchRequest) (*DecreaseStockBatchResponse, error) {
+	if len(in.Lines) == 0 {
+		return &DecreaseStockBatchResponse{}, status.Errorf(codes.InvalidArgument, "lines cannot be empty")
+	}
+
+	ctx = stock.WithReference(ctx, in.ReferenceId)
+	lines := make([]stock.Line, 0, len(in.Lines))
+	for _, l := range in.Lines {
+		lines = append(lines, stock.Line{ID: int(l.ID), Amount: int(l.Amount)})
+	}
+
+	remaining, err := stock.DecreaseBatch(ctx, s.db, lines)
+	if err != nil {
+		var batchErr *stock.BatchError
+		if errors.As(err, &batchErr) {
+			s.log(ctx).Warnf("stock batch rejected: %v", batchErr)
+			failure := &DecreaseStockBatchFailure{}
+			for _, f := range batchErr.Failures {
+				failure.Failures = append(failure.Failures, &StockLineFailure{
+					ID:        int32(f.ID),
+					Reason:    failureReason(f.Err),
+					Requested: int32(f.Requested),
+					Available: int32(f.Available),
+				})
+			}
+			st, err := status.New(codes.InvalidArgument, batchErr.Error()).WithDetails(failure)
+			if err != nil {
+				return &DecreaseStockBatchResponse{}, status.Error(codes.InvalidArgument, batchErr.Error())
+			}
+			return &DecreaseStockBatchResponse{}, st.Err()
+		}
+		s.log(ctx).Warnf("failed to update stock data: %v", err)
+		return &DecreaseStockBatchResponse{}, status.Errorf(codes.Internal, "failed to update stock data")
+	}
+
+	res := &DecreaseStockBatchResponse{}
+	seen := make(map[int32]bool, len(in.Lines))
+	for _, l := range in.Lines {
+		if seen[l.ID] {
+			continue
 		}
-		s.logger.Warnf("failed to find product with given ID")
-		return &DecreaseStockResponse{}, status.Errorf(codes.Internal, "failed to find product with given ID")
+		seen[l.ID] = true
+		res.Stocks = append(res.Stocks, &StockLevel{ID: l.ID, Stock: int32(remaining[int(l.ID)])})
+	}
+	return res, nil
+}
+
+func failureReason(err error) StockLineFailure_Reason {
+	switch {
+	case errors.Is(err, stock.ErrNotFound):
+		return StockLineFailure_NOT_FOUND
+	case errors.Is(err, stock.ErrInsufficientStock):
+		return StockLineFailure_INSUFFICIENT_STOCK
+	case errors.Is(err, stock.ErrInvalidAmount):
+		return StockLineFailure_INVALID_AMOUNT
+	}
+	return StockLineFailure_REASON_UNSPECIFIED
+}
+
+func (s Server) Reserve(ctx context.Context, in *ReserveRequest) (*ReserveResponse, error) {
+	ttl := time.Duration(in.TtlSeconds) * time.Second
+	r, err := stock.Reserve(ctx, s.db, int(in.ID), int(in.Amount), in.Owner, ttl)
+	if err != nil {
+		s.log(ctx).Warnf("failed to reserve stock: %v", err)
+		return &ReserveResponse{}, stockError(err)
+	}
+
+	return &ReserveResponse{
+		ReservationId: int32(r.ID),
+		ExpiresAt:     timestamppb.New(r.ExpiresAt),
+	}, nil
+}
+
+func (s Server) ConfirmReservation(ctx context.Context, in *ConfirmReservationRequest) (*ConfirmReservationResponse, error) {
+	remaining, err := stock.Confirm(ctx, s.db, int(in.ReservationId))
+	if err != nil {
+		s.log(ctx).Warnf("failed to confirm reservation: %v", err)
+		return &ConfirmReservationResponse{}, stockError(err)
 	}
-	if p.Stock < int(in.Amount) {
-		s.logger.Warnf("stock is not enough")
-		return &DecreaseStockResponse{}, status.Errorf(codes.InvalidArgument, "stock is not enough")
+
+	return &ConfirmReservationResponse{Stock: int32(remaining)}, nil
+}
+
+func (s Server) CancelReservation(ctx context.Context, in *CancelReservationRequest) (*CancelReservationResponse, error) {
+	if err := stock.Cancel(ctx, s.db, int(in.ReservationId)); err != nil {
+		s.log(ctx).Warnf("failed to cancel reservation: %v", err)
+		return &CancelReservationResponse{}, stockError(err)
+	}
+
+	return &CancelReservationResponse{}, nil
+}
+
+func (s Server) IncreaseStock(ctx context.Context, in *IncreaseStockRequest) (*IncreaseStockResponse, error) {
+	var reason stockmovement.Reason
+	switch in.Reason {
+	case IncreaseStockRequest_RESTOCK:
+		reason = stockmovement.ReasonRestock
+	case IncreaseStockRequest_RETURN:
+		reason = stockmovement.ReasonReturn
+	case IncreaseStockRequest_CANCELLATION:
+		reason = stockmovement.ReasonCancellation
+	default:
+		return &IncreaseStockResponse{}, status.Errorf(codes.InvalidArgument, "reason must be given")
+	}
+
+	ctx = stock.WithReference(ctx, in.ReferenceId)
+	remaining, err := stock.Increase(ctx, s.db, int(in.ID), int(in.Amount), reason)
+	if err != nil {
+		s.log(ctx).Warnf("failed to increase stock: %v", err)
+		return &IncreaseStockResponse{}, stockError(err)
 	}
-	_, err = s.db.Product.Update().SetStock(int(in.Amount)).Save(ctx)
+
+	return &IncreaseStockResponse{Stock: int32(remaining)}, nil
+}
+
+func (s Server) AdjustStock(ctx context.Context, in *AdjustStockRequest) (*AdjustStockResponse, error) {
+	ctx = stock.WithReference(ctx, in.ReferenceId)
+	remaining, err := stock.Adjust(ctx, s.db, int(in.ID), int(in.Delta))
 	if err != nil {
-		s.logger.Warnf("failed to update stock data ")
-		return &DecreaseStockResponse{}, status.Errorf(codes.Internal, "failed to update stock data")
+		s.log(ctx).Warnf("failed to adjust stock: %v", err)
+		return &AdjustStockResponse{}, stockError(err)
 	}
 
-	return &DecreaseStockResponse{}, nil
+	return &AdjustStockResponse{Stock: int32(remaining)}, nil
+}
+
+// stockError maps errors of the stock package to gRPC statuses.
+func stockError(err error) error {
+	switch {
+	case errors.Is(err, stock.ErrNotFound), errors.Is(err, stock.ErrReservationNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, stock.ErrInsufficientStock),
+		errors.Is(err, stock.ErrInvalidAmount),
+		errors.Is(err, stock.ErrInvalidOwner),
+		errors.Is(err, stock.ErrInvalidTTL),
+		errors.Is(err, stock.ErrInvalidReason):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, stock.ErrReservationNotActive):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Errorf(codes.Internal, "failed to update stock data")
 }
@@ -0,0 +1,278 @@
+package grpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/law-a-1/product-service/ent"
+	"github.com/law-a-1/product-service/ent/idempotencykey"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	idempotencyKeyMetadata      = "idempotency-key"
+	DefaultIdempotencyRetention = 24 * time.Hour
+	DefaultIdempotencyLease     = time.Minute
+)
+
+// idempotentMethods lists the RPCs that mutate stock and therefore honour
+// idempotency keys.
+var idempotentMethods = map[string]bool{
+	"/Product/DecreaseStock":      true,
+	"/Product/DecreaseStockBatch": true,
+	"/Product/Reserve":            true,
+	"/Product/ConfirmReservation": true,
+	"/Product/CancelReservation":  true,
+	"/Product/IncreaseStock":      true,
+	"/Product/AdjustStock":        true,
+}
+
+type idempotentRequest interface {
+	GetIdempotencyKey() string
+}
+
+// idempotencyInterceptor records the outcome of stock mutating calls under
+// their idempotency key and replays it for retries carrying the same key. Keys
+// are scoped to the caller, so different callers may pick the same key. It
+// must run after the auth interceptor.
+func (s Server) idempotencyInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (res interface{}, herr error) {
+	if !idempotentMethods[info.FullMethod] {
+		return handler(ctx, req)
+	}
+	key := idempotencyKey(ctx, req)
+	if key == "" {
+		return handler(ctx, req)
+	}
+
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return handler(ctx, req)
+	}
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to hash request")
+	}
+	sum := sha256.Sum256(b)
+	hash := hex.EncodeToString(sum[:])
+
+	record, replayed, err := s.claimIdempotencyKey(ctx, callerFrom(ctx), info.FullMethod, key, hash)
+	if err != nil {
+		return nil, err
+	}
+	if replayed != nil {
+		return replayOutcome(replayed)
+	}
+
+	// The caller may already be gone, but the outcome still has to be stored
+	// for its retry.
+	saveCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	release := func() {
+		if err := s.db.IdempotencyKey.DeleteOne(record).Exec(saveCtx); err != nil {
+			s.log(ctx).Warnf("failed to release idempotency key %q: %v", key, err)
+		}
+	}
+	// A panicking handler must not leave the key pending until it expires,
+	// which would abort every retry.
+	defer func() {
+		if v := recover(); v != nil {
+			release()
+			panic(v)
+		}
+	}()
+
+	if err := ctx.Err(); err != nil {
+		// The caller gave up before anything ran, so the retry may run again.
+		release()
+		return nil, status.FromContextError(err).Err()
+	}
+
+	res, herr = handler(ctx, req)
+
+	if herr != nil && retryable(status.Code(herr)) {
+		// Nothing was changed, so release the key and let the retry run again.
+		release()
+		return res, herr
+	}
+
+	upd := record.Update().SetState(idempotencykey.StateCompleted)
+	if herr != nil {
+		st, err := proto.Marshal(status.Convert(herr).Proto())
+		if err != nil {
+			s.log(ctx).Warnf("failed to marshal status for idempotency key %q: %v", key, err)
+			return res, herr
+		}
+		upd.SetStatus(st)
+	} else if m, ok := res.(proto.Message); ok {
+		a, err := anypb.New(m)
+		if err == nil {
+			var b []byte
+			b, err = proto.Marshal(a)
+			upd.SetResponse(b)
+		}
+		if err != nil {
+			s.log(ctx).Warnf("failed to marshal response for idempotency key %q: %v", key, err)
+			return res, herr
+		}
+	}
+	if err := upd.Exec(saveCtx); err != nil {
+		s.log(ctx).Warnf("failed to store outcome for idempotency key %q: %v", key, err)
+	}
+	return res, herr
+}
+
+// claimIdempotencyKey stores a pending record for the key. When the key was
+// already used, the existing completed record is returned for replay instead.
+// A pending record whose lease ran out is taken over, since the call holding
+// it was lost, e.g. with a crashed replica.
+func (s Server) claimIdempotencyKey(ctx context.Context, caller, method, key, hash string) (*ent.IdempotencyKey, *ent.IdempotencyKey, error) {
+	for attempt := 0; attempt < 2; attempt++ {
+		record, err := s.db.IdempotencyKey.
+			Create().
+			SetCaller(caller).
+			SetMethod(method).
+			SetKey(key).
+			SetRequestHash(hash).
+			SetExpiresAt(time.Now().Add(s.idempotencyRetention)).
+			Save(ctx)
+		if err == nil {
+			return record, nil, nil
+		}
+		if !ent.IsConstraintError(err) {
+			s.log(ctx).Warnf("failed to store idempotency key %q: %v", key, err)
+			return nil, nil, status.Errorf(codes.Internal, "failed to store idempotency key")
+		}
+
+		existing, err := s.db.IdempotencyKey.
+			Query().
+			Where(idempotencykey.Caller(caller), idempotencykey.Method(method), idempotencykey.Key(key)).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				// Released by a failed call in the meantime, claim it again.
+				continue
+			}
+			s.log(ctx).Warnf("failed to load idempotency key %q: %v", key, err)
+			return nil, nil, status.Errorf(codes.Internal, "failed to load idempotency key")
+		}
+		if !existing.ExpiresAt.After(time.Now()) {
+			// Past the retention window the key may be reused.
+			if _, err := s.db.IdempotencyKey.
+				Delete().
+				Where(idempotencykey.ID(existing.ID), idempotencykey.ExpiresAtLTE(time.Now())).
+				Exec(ctx); err != nil {
+				return nil, nil, status.Errorf(codes.Internal, "failed to release idempotency key")
+			}
+			continue
+		}
+		if existing.RequestHash != hash {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "idempotency key was already used for a different request")
+		}
+		if existing.State == idempotencykey.StatePending {
+			now := time.Now()
+			if existing.ClaimedAt.After(now.Add(-s.idempotencyLease)) {
+				return nil, nil, status.Errorf(codes.Aborted, "a request with the same idempotency key is in progress")
+			}
+			// Only one retry may win the takeover.
+			n, err := s.db.IdempotencyKey.
+				Update().
+				Where(
+					idempotencykey.ID(existing.ID),
+					idempotencykey.StateEQ(idempotencykey.StatePending),
+					idempotencykey.ClaimedAt(existing.ClaimedAt),
+				).
+				SetClaimedAt(now).
+				Save(ctx)
+			if err != nil {
+				s.log(ctx).Warnf("failed to take over idempotency key %q: %v", key, err)
+				return nil, nil, status.Errorf(codes.Internal, "failed to store idempotency key")
+			}
+			if n == 0 {
+				continue
+			}
+			s.log(ctx).Warnf("took over idempotency key %q claimed at %s", key, existing.ClaimedAt)
+			existing.ClaimedAt = now
+			return existing, nil, nil
+		}
+		return nil, existing, nil
+	}
+	return nil, nil, status.Errorf(codes.Aborted, "a request with the same idempotency key is in progress")
+}
+
+func replayOutcome(record *ent.IdempotencyKey) (interface{}, error) {
+	if len(record.Status) > 0 {
+		var st spb.Status
+		if err := proto.Unmarshal(record.Status, &st); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to replay idempotent request")
+		}
+		return nil, status.FromProto(&st).Err()
+	}
+
+	var a anypb.Any
+	if err := proto.Unmarshal(record.Response, &a); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to replay idempotent request")
+	}
+	res, err := a.UnmarshalNew()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to replay idempotent request")
+	}
+	return res, nil
+}
+
+func idempotencyKey(ctx context.Context, req interface{}) string {
+	if r, ok := req.(idempotentRequest); ok && r.GetIdempotencyKey() != "" {
+		return r.GetIdempotencyKey()
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(idempotencyKeyMetadata); len(v) > 0 {
+			return v[0]
+		}
+	}
+	return ""
+}
+
+// retryable reports whether a call that failed with code is known to have
+// been turned away before changing anything, in which case its outcome is not
+// remembered. Other failures, such as Internal, may follow a committed
+// transaction and are replayed so that a retry cannot apply the call twice.
+func retryable(code codes.Code) bool {
+	switch code {
+	case codes.ResourceExhausted, codes.Unavailable:
+		return true
+	}
+	return false
+}
+
+// PurgeIdempotencyKeys deletes idempotency keys past their retention window
+// every interval until ctx is done.
+func (s Server) PurgeIdempotencyKeys(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := s.db.IdempotencyKey.
+				Delete().
+				Where(idempotencykey.ExpiresAtLTE(time.Now())).
+				Exec(ctx)
+			if err != nil {
+				s.logger.Warnf("failed to purge idempotency keys: %v", err)
+				continue
+			}
+			if n > 0 {
+				s.logger.Infof("purged %d expired idempotency keys", n)
+			}
+		}
+	}
+}
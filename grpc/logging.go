@@ -0,0 +1,100 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/law-a-1/product-service/logging"
+	"github.com/law-a-1/product-service/tracing"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// requestIDKey is the metadata key carrying the request ID of a call.
+var requestIDKey = strings.ToLower(logging.RequestIDHeader)
+
+type callEntryKey struct{}
+
+// callEntry is the log entry of a single call. authorize records the caller
+// on it.
+type callEntry struct {
+	logger *zap.SugaredLogger
+	start  time.Time
+	caller []interface{}
+}
+
+// startCall returns a context carrying a logger for the call of method. The
+// request ID is taken from the incoming metadata when it is usable, generated
+// otherwise, and sent back in the response header.
+func (s Server) startCall(ctx context.Context, method string) (context.Context, *callEntry) {
+	var incoming string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(requestIDKey); len(v) > 0 {
+			incoming = v[0]
+		}
+	}
+	id := logging.RequestID(incoming)
+	grpc.SetHeader(ctx, metadata.Pairs(requestIDKey, id))
+
+	logger := s.logger.With("request_id", id, "method", method)
+	if traceID := tracing.TraceID(ctx); traceID != "" {
+		logger = logger.With("trace_id", traceID)
+	}
+	entry := &callEntry{logger: logger, start: time.Now()}
+	ctx = context.WithValue(ctx, callEntryKey{}, entry)
+	return logging.NewContext(ctx, entry.logger), entry
+}
+
+// finish logs the outcome of the call.
+func (e *callEntry) finish(err error) {
+	st := status.Convert(err)
+	fields := append([]interface{}{
+		"code", st.Code().String(),
+		"latency", time.Since(e.start),
+	}, e.caller...)
+	if err != nil {
+		fields = append(fields, "error", st.Message())
+	}
+	e.logger.Infow("call", fields...)
+}
+
+// withCaller records the caller of the call on its log entry and on the logger
+// of the returned context.
+func (s Server) withCaller(ctx context.Context, key string, value interface{}) context.Context {
+	if entry, ok := ctx.Value(callEntryKey{}).(*callEntry); ok {
+		entry.caller = []interface{}{key, value}
+	}
+	return logging.NewContext(ctx, s.log(ctx).With(key, value))
+}
+
+// log returns the logger of the call of ctx.
+func (s Server) log(ctx context.Context) *zap.SugaredLogger {
+	return logging.FromContext(ctx, s.logger)
+}
+
+// healthPrefix prefixes the methods of the health service, whose calls are
+// not logged since probes make them every few seconds.
+const healthPrefix = "/grpc.health.v1.Health/"
+
+func (s Server) loggingUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if strings.HasPrefix(info.FullMethod, healthPrefix) {
+		return handler(ctx, req)
+	}
+	ctx, entry := s.startCall(ctx, info.FullMethod)
+	res, err := handler(ctx, req)
+	entry.finish(err)
+	return res, err
+}
+
+func (s Server) loggingStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if strings.HasPrefix(info.FullMethod, healthPrefix) {
+		return handler(srv, ss)
+	}
+	ctx, entry := s.startCall(ss.Context(), info.FullMethod)
+	err := handler(srv, authorizedStream{ServerStream: ss, ctx: ctx})
+	entry.finish(err)
+	return err
+}
@@ -0,0 +1,239 @@
+// Package health reports whether the service is alive and ready to serve, over
+// HTTP probes and the standard gRPC health service.
+//
+// Liveness only tells that the process answers. Readiness requires every
+// required check to pass and the service not to be shutting down; optional
+// checks are reported without affecting it, so that an outage of a
+// dependency shared by all replicas does not take every replica out of
+// rotation.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// checkTimeout bounds each check.
+const checkTimeout = 3 * time.Second
+
+// CheckFunc checks a dependency, returning an error when it is unusable.
+type CheckFunc func(ctx context.Context) error
+
+type check struct {
+	name     string
+	fn       CheckFunc
+	required bool
+}
+
+// Report is the outcome of the last run of the checks.
+type Report struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+// Checker runs the checks and publishes their outcome.
+type Checker struct {
+	logger   *zap.SugaredLogger
+	services []string
+	grpc     *health.Server
+
+	mu           sync.RWMutex
+	checks       []check
+	checked      bool
+	ready        bool
+	shuttingDown bool
+	report       Report
+}
+
+// New returns a Checker, not ready until its checks first pass. The gRPC
+// health service reports the overall status under "" and under each of
+// services.
+func New(logger *zap.SugaredLogger, services ...string) *Checker {
+	c := &Checker{
+		logger:   logger,
+		services: services,
+		grpc:     health.NewServer(),
+		report:   Report{Status: "starting"},
+	}
+	c.setServing(false)
+	return c
+}
+
+// Require adds a check that must pass for the service to be ready.
+func (c *Checker) Require(name string, fn CheckFunc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checks = append(c.checks, check{name: name, fn: fn, required: true})
+}
+
+// Optional adds a check that is reported but does not affect readiness.
+func (c *Checker) Optional(name string, fn CheckFunc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checks = append(c.checks, check{name: name, fn: fn})
+}
+
+// Run runs the checks now and then every interval until ctx is done.
+func (c *Checker) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		c.runChecks(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Shutdown marks the service as not ready for good, so that load balancers
+// stop sending it traffic while it drains.
+func (c *Checker) Shutdown() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.shuttingDown = true
+	c.ready = false
+	c.report.Status = "shutting down"
+	c.grpc.Shutdown()
+}
+
+// Ready reports whether the service is ready and the outcome of the checks.
+func (c *Checker) Ready() (bool, Report) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.ready, c.report
+}
+
+// GRPC returns the gRPC health service.
+func (c *Checker) GRPC() healthpb.HealthServer {
+	return c.grpc
+}
+
+func (c *Checker) runChecks(ctx context.Context) {
+	c.mu.RLock()
+	checks := append([]check(nil), c.checks...)
+	c.mu.RUnlock()
+
+	results := make([]error, len(checks))
+	var wg sync.WaitGroup
+	for i, ch := range checks {
+		wg.Add(1)
+		go func(i int, ch check) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+			defer cancel()
+			results[i] = ch.fn(ctx)
+		}(i, ch)
+	}
+	wg.Wait()
+
+	// Errors are logged rather than reported, since probes are served to
+	// anyone and errors may name hosts and users.
+	ready := true
+	report := Report{Checks: make(map[string]string, len(checks))}
+	failures := make(map[string]string)
+	for i, ch := range checks {
+		report.Checks[ch.name] = "ok"
+		if err := results[i]; err != nil {
+			report.Checks[ch.name] = "failed"
+			failures[ch.name] = err.Error()
+			if ch.required {
+				ready = false
+			}
+		}
+	}
+	report.Status = "ready"
+	if !ready {
+		report.Status = "not ready"
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.shuttingDown {
+		return
+	}
+	if ready != c.ready || !c.checked {
+		if ready {
+			c.logger.Info("service is ready")
+		} else {
+			c.logger.Warnw("service is not ready", "failures", failures)
+		}
+		c.setServing(ready)
+	}
+	c.checked = true
+	c.ready = ready
+	c.report = report
+}
+
+func (c *Checker) setServing(serving bool) {
+	status := healthpb.HealthCheckResponse_NOT_SERVING
+	if serving {
+		status = healthpb.HealthCheckResponse_SERVING
+	}
+	c.grpc.SetServingStatus("", status)
+	for _, s := range c.services {
+		c.grpc.SetServingStatus(s, status)
+	}
+}
+
+// Middleware answers GET and HEAD requests to /livez and /readyz, and to
+// /health as an alias of /readyz, before they reach the rest of the chain.
+func (c *Checker) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			next.ServeHTTP(w, r)
+			return
+		}
+		switch r.URL.Path {
+		case "/livez":
+			writeReport(w, http.StatusOK, Report{Status: "alive"})
+		case "/readyz", "/health":
+			ready, report := c.Ready()
+			code := http.StatusOK
+			if !ready {
+				code = http.StatusServiceUnavailable
+			}
+			writeReport(w, code, report)
+		default:
+			next.ServeHTTP(w, r)
+		}
+	})
+}
+
+func writeReport(w http.ResponseWriter, code int, report Report) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(report)
+}
+
+// HTTPCheck checks that the server at url answers a HEAD request, whatever
+// the status of the response short of a server error. HEAD keeps the probe
+// from doing the work of the endpoint, which is usually not a health
+// endpoint.
+func HTTPCheck(client *http.Client, url string) CheckFunc {
+	return func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
+		if err != nil {
+			return err
+		}
+		res, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		res.Body.Close()
+		if res.StatusCode >= http.StatusInternalServerError {
+			return fmt.Errorf("responded %d", res.StatusCode)
+		}
+		return nil
+	}
+}
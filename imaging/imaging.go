@@ -0,0 +1,126 @@
+// Package imaging generates the resized variants of uploaded product images.
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/draw"
+	_ "image/gif" // Register the GIF decoder
+	"image/jpeg"
+	"image/png"
+	"io"
+
+	"github.com/HugoSmits86/nativewebp"
+	xdraw "golang.org/x/image/draw"
+	_ "golang.org/x/image/webp" // Register the WebP decoder
+)
+
+// MaxPixels bounds the size of images that are decoded, so a small file
+// cannot expand into an enormous bitmap.
+const MaxPixels = 40_000_000
+
+var (
+	ErrUnsupportedImage = errors.New("image cannot be decoded")
+	ErrImageTooLarge    = errors.New("image dimensions are too large")
+)
+
+// Size is a named bounding box images are scaled down to fit.
+type Size struct {
+	Name      string
+	MaxWidth  int
+	MaxHeight int
+}
+
+// Sizes lists the generated variants from largest to smallest. Smaller
+// variants are scaled from the previous one, which is much faster than
+// scaling every variant from the original.
+var Sizes = []Size{
+	{Name: "large", MaxWidth: 1200, MaxHeight: 1200},
+	{Name: "medium", MaxWidth: 600, MaxHeight: 600},
+	{Name: "thumbnail", MaxWidth: 150, MaxHeight: 150},
+}
+
+// File is an encoded variant ready to be stored.
+type File struct {
+	Size   string
+	Ext    string
+	Width  int
+	Height int
+	Data   []byte
+}
+
+// Variants decodes the image read from r and encodes every size of Sizes
+// twice, once as JPEG (PNG for images with transparency) and once as WebP.
+func Variants(r io.Reader) ([]File, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedImage
+	}
+	if cfg.Width*cfg.Height > MaxPixels {
+		return nil, ErrImageTooLarge
+	}
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedImage
+	}
+	opaque := isOpaque(src)
+
+	var files []File
+	for _, size := range Sizes {
+		src = fit(src, size)
+		b := src.Bounds()
+
+		var buf bytes.Buffer
+		ext := ".jpg"
+		if opaque {
+			err = jpeg.Encode(&buf, src, &jpeg.Options{Quality: 85})
+		} else {
+			ext = ".png"
+			err = png.Encode(&buf, src)
+		}
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, File{Size: size.Name, Ext: ext, Width: b.Dx(), Height: b.Dy(), Data: buf.Bytes()})
+
+		var webp bytes.Buffer
+		if err := nativewebp.Encode(&webp, src, nil); err != nil {
+			return nil, err
+		}
+		files = append(files, File{Size: size.Name, Ext: ".webp", Width: b.Dx(), Height: b.Dy(), Data: webp.Bytes()})
+	}
+	return files, nil
+}
+
+// fit scales img down to fit size, keeping its aspect ratio. Images already
+// fitting are returned as they are, they are never scaled up.
+func fit(img image.Image, size Size) image.Image {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w <= size.MaxWidth && h <= size.MaxHeight {
+		return img
+	}
+
+	if w*size.MaxHeight > h*size.MaxWidth {
+		h = max(1, h*size.MaxWidth/w)
+		w = size.MaxWidth
+	} else {
+		w = max(1, w*size.MaxHeight/h)
+		h = size.MaxHeight
+	}
+	dst := image.NewNRGBA(image.Rect(0, 0, w, h))
+	xdraw.CatmullRom.Scale(dst, dst.Bounds(), img, b, draw.Src, nil)
+	return dst
+}
+
+func isOpaque(img image.Image) bool {
+	if o, ok := img.(interface{ Opaque() bool }); ok {
+		return o.Opaque()
+	}
+	return false
+}
@@ -1,42 +1,134 @@
 package main
 
 import (
+	"context"
 	"fmt"
+	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
+	"github.com/law-a-1/product-service/config"
+	"github.com/law-a-1/product-service/logging"
+	"github.com/law-a-1/product-service/logship"
+	"github.com/law-a-1/product-service/tracing"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 	"net/http"
 	"time"
 )
 
-func NewLogger() (*zap.SugaredLogger, error) {
-	logger, err := zap.NewDevelopment()
+// logShipper ships a log entry of every JSON response to the log service. It
+// is set by NewLogShipper when a log service is configured and closed once
+// the servers have stopped.
+var logShipper *logship.Shipper
+
+// NewLogShipper starts shipping to the log service of cfg, if any. Its client
+// is not traced, since every entry would start a trace of its own.
+func NewLogShipper(cfg config.Log) {
+	if cfg.ServiceURL == "" {
+		return
+	}
+	logShipper = logship.New(logship.Config{
+		URL:           cfg.ServiceURL,
+		Service:       "products",
+		QueueSize:     cfg.ShipQueueSize,
+		BatchSize:     cfg.ShipBatchSize,
+		FlushInterval: cfg.ShipFlushInterval,
+	})
+}
+
+func NewLogger(cfg config.Log) (*zap.SugaredLogger, error) {
+	level, err := zap.ParseAtomicLevel(cfg.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	zapCfg := zap.NewDevelopmentConfig()
+	if cfg.Format == "json" {
+		zapCfg = zap.NewProductionConfig()
+		// Every request is logged, so none may be sampled away.
+		zapCfg.Sampling = nil
+		zapCfg.EncoderConfig.TimeKey = "time"
+		zapCfg.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+		zapCfg.EncoderConfig.EncodeDuration = zapcore.MillisDurationEncoder
+	}
+	zapCfg.Level = level
+
+	logger, err := zapCfg.Build()
 	if err != nil {
 		return nil, err
 	}
 	return logger.Sugar(), nil
 }
 
-type SugaredRequestLogger struct {
-	Logger  *zap.SugaredLogger
-	request *http.Request
+// RequestLogger logs every request with its ID, route pattern, user, status
+// and latency. The request ID is taken from the X-Request-ID header when it is
+// usable and generated otherwise, and is echoed in the response. Handlers get
+// a logger carrying the request ID with s.log.
+func (s Server) RequestLogger(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		id := logging.RequestID(r.Header.Get(logging.RequestIDHeader))
+		w.Header().Set(logging.RequestIDHeader, id)
+
+		logger := s.logger.With("request_id", id)
+		if traceID := tracing.TraceID(r.Context()); traceID != "" {
+			logger = logger.With("trace_id", traceID)
+		}
+		entry := &requestLogEntry{logger: logger, request: r}
+		ctx := context.WithValue(r.Context(), middleware.RequestIDKey, id)
+		ctx = logging.NewContext(ctx, entry.logger)
+		r = middleware.WithLogEntry(r.WithContext(ctx), entry)
+
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		defer func() {
+			entry.Write(ww.Status(), ww.BytesWritten(), ww.Header(), time.Since(start), nil)
+		}()
+		next.ServeHTTP(ww, r)
+	})
 }
 
-func (l *SugaredRequestLogger) NewLogEntry(r *http.Request) middleware.LogEntry {
-	l.request = r
+// log returns the logger of the request r.
+func (s Server) log(r *http.Request) *zap.SugaredLogger {
+	return logging.FromContext(r.Context(), s.logger)
+}
 
-	return l
+// requestLogEntry is the log entry of a single request. IsAuthorized records
+// the user on it.
+type requestLogEntry struct {
+	logger  *zap.SugaredLogger
+	request *http.Request
+	userID  *int
+	panic   []interface{}
 }
 
-func (l *SugaredRequestLogger) Write(status, bytes int, header http.Header, elapsed time.Duration, extra interface{}) {
-	l.Logger.Infof(
-		"%s %s%s - %s %dB in %f",
-		l.request.Method, l.request.Host, l.request.RequestURI, http.StatusText(status), bytes,
-		float64(elapsed.Nanoseconds())/1000000.0)
+func (e *requestLogEntry) Write(status, bytes int, header http.Header, elapsed time.Duration, extra interface{}) {
+	if status == 0 {
+		status = http.StatusOK
+	}
+	fields := []interface{}{
+		"method", e.request.Method,
+		"path", e.request.URL.Path,
+		"status", status,
+		"bytes", bytes,
+		"latency", elapsed,
+	}
+	if rctx := chi.RouteContext(e.request.Context()); rctx != nil && rctx.RoutePattern() != "" {
+		fields = append(fields, "route", rctx.RoutePattern())
+	}
+	if e.userID != nil {
+		fields = append(fields, "user_id", *e.userID)
+	}
+	fields = append(fields, e.panic...)
+
+	if status >= http.StatusInternalServerError {
+		e.logger.Errorw("request", fields...)
+		return
+	}
+	e.logger.Infow("request", fields...)
 }
 
-func (l *SugaredRequestLogger) Panic(v interface{}, stack []byte) {
-	l.Logger = l.Logger.With(
+func (e *requestLogEntry) Panic(v interface{}, stack []byte) {
+	e.panic = []interface{}{
 		"stack", string(stack),
 		"panic", fmt.Sprintf("%+v", v),
-	)
+	}
 }
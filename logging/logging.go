@@ -0,0 +1,57 @@
+// Package logging carries request-scoped loggers and request IDs through
+// contexts, so that every line logged while serving a request or a call can
+// be correlated.
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"go.uber.org/zap"
+)
+
+// RequestIDHeader is the HTTP header, and lowercased the gRPC metadata key,
+// carrying the request ID.
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength bounds incoming request IDs, which end up in every line
+// logged for the request.
+const maxRequestIDLength = 128
+
+type loggerKey struct{}
+
+// NewContext returns a copy of ctx carrying logger.
+func NewContext(ctx context.Context, logger *zap.SugaredLogger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// FromContext returns the logger of ctx, or fallback when it has none.
+func FromContext(ctx context.Context, fallback *zap.SugaredLogger) *zap.SugaredLogger {
+	if logger, ok := ctx.Value(loggerKey{}).(*zap.SugaredLogger); ok {
+		return logger
+	}
+	return fallback
+}
+
+// RequestID returns incoming if it is a usable request ID, that is printable
+// ASCII of reasonable length, and a new random ID otherwise.
+func RequestID(incoming string) string {
+	if incoming != "" && len(incoming) <= maxRequestIDLength && printable(incoming) {
+		return incoming
+	}
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
+
+func printable(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 0x21 || s[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
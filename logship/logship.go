@@ -0,0 +1,274 @@
+// Package logship ships log entries to the log service. Entries are queued and
+// posted by a background goroutine, which takes them off the queue in
+// batches, so logging never waits on the log service.
+package logship
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	DefaultQueueSize     = 10000
+	DefaultBatchSize     = 100
+	DefaultFlushInterval = 2 * time.Second
+	DefaultMaxRetries    = 3
+	DefaultTimeout       = 5 * time.Second
+
+	baseBackoff = 500 * time.Millisecond
+)
+
+// Config configures a Shipper. Zero values select the defaults above.
+type Config struct {
+	// URL is the endpoint every entry is posted to as a JSON object.
+	URL string
+	// Service names this service in every entry.
+	Service       string
+	QueueSize     int
+	BatchSize     int
+	FlushInterval time.Duration
+	// MaxRetries is how often a failed entry is retried, with exponential
+	// backoff, before the rest of its batch is dropped. Negative values
+	// disable retries.
+	MaxRetries int
+	Timeout    time.Duration
+	// HTTPClient posts the entries, with its timeout replaced by Timeout. It
+	// should not be traced, or every entry would start a trace of its own.
+	HTTPClient *http.Client
+}
+
+// Entry is a log entry as sent to the log service. Type is INFO or ERROR.
+type Entry struct {
+	Type    string `json:"type"`
+	Service string `json:"service"`
+	Message string `json:"message"`
+}
+
+// Shipper queues entries and ships them in the background.
+type Shipper struct {
+	cfg    Config
+	client *http.Client
+
+	// ctx is cancelled by Close, aborting a post in flight.
+	ctx     context.Context
+	cancel  context.CancelFunc
+	queue   chan Entry
+	flushes chan chan struct{}
+	stop    chan struct{}
+	done    chan struct{}
+	dropped atomic.Uint64
+}
+
+// New returns a Shipper and starts shipping.
+func New(cfg Config) *Shipper {
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = DefaultQueueSize
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = DefaultBatchSize
+	}
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = DefaultFlushInterval
+	}
+	if cfg.MaxRetries < 0 {
+		cfg.MaxRetries = 0
+	} else if cfg.MaxRetries == 0 {
+		cfg.MaxRetries = DefaultMaxRetries
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+
+	client := &http.Client{}
+	if cfg.HTTPClient != nil {
+		*client = *cfg.HTTPClient
+	}
+	client.Timeout = cfg.Timeout
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &Shipper{
+		cfg:     cfg,
+		client:  client,
+		ctx:     ctx,
+		cancel:  cancel,
+		queue:   make(chan Entry, cfg.QueueSize),
+		flushes: make(chan chan struct{}),
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	go s.run()
+	return s
+}
+
+// Enqueue queues e without blocking, naming the service in it when it does
+// not. The entry is dropped when the queue is full.
+func (s *Shipper) Enqueue(e Entry) {
+	if e.Service == "" {
+		e.Service = s.cfg.Service
+	}
+	select {
+	case s.queue <- e:
+	default:
+		s.dropped.Add(1)
+	}
+}
+
+// Dropped returns the number of entries dropped because the queue was full or
+// the log service kept failing.
+func (s *Shipper) Dropped() uint64 {
+	return s.dropped.Load()
+}
+
+// Flush ships the queued entries, waiting until they are sent or ctx is done.
+func (s *Shipper) Flush(ctx context.Context) error {
+	done := make(chan struct{})
+	select {
+	case s.flushes <- done:
+	case <-s.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close ships the queued entries and stops the Shipper. When ctx is done
+// first, the post in flight is aborted and the entries still queued are
+// dropped.
+func (s *Shipper) Close(ctx context.Context) error {
+	err := s.Flush(ctx)
+	s.cancel()
+	select {
+	case <-s.done:
+	default:
+		close(s.stop)
+		<-s.done
+	}
+	return err
+}
+
+func (s *Shipper) run() {
+	defer close(s.done)
+
+	ticker := time.NewTicker(s.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]Entry, 0, s.cfg.BatchSize)
+	ship := func() {
+		if len(batch) > 0 {
+			s.send(batch)
+			batch = batch[:0]
+		}
+	}
+	// drain ships everything queued so far.
+	drain := func() {
+		for {
+			select {
+			case e := <-s.queue:
+				batch = append(batch, e)
+				if len(batch) == s.cfg.BatchSize {
+					ship()
+				}
+			default:
+				ship()
+				return
+			}
+		}
+	}
+
+	for {
+		select {
+		case e := <-s.queue:
+			batch = append(batch, e)
+			if len(batch) == s.cfg.BatchSize {
+				ship()
+			}
+		case <-ticker.C:
+			ship()
+		case done := <-s.flushes:
+			drain()
+			close(done)
+		case <-s.stop:
+			drain()
+			return
+		}
+	}
+}
+
+// send posts the entries of batch one at a time, since the log service takes
+// a single entry per request. Failed posts are retried with exponential
+// backoff and jitter on network errors, 429 and 5xx responses. Once retries
+// run out the service is considered down and the rest of the batch is dropped
+// too; entries it rejects outright are dropped alone.
+func (s *Shipper) send(batch []Entry) {
+	for i, e := range batch {
+		body, err := json.Marshal(e)
+		if err != nil {
+			s.dropped.Add(1)
+			continue
+		}
+		if !s.sendEntry(body) {
+			s.dropped.Add(uint64(len(batch) - i))
+			return
+		}
+	}
+}
+
+// sendEntry posts body and reports whether the log service is still worth
+// trying.
+func (s *Shipper) sendEntry(body []byte) bool {
+	for attempt := 0; ; attempt++ {
+		retry, err := s.post(body)
+		if err == nil {
+			return true
+		}
+		if !retry {
+			s.dropped.Add(1)
+			return true
+		}
+		if attempt == s.cfg.MaxRetries || s.ctx.Err() != nil {
+			return false
+		}
+
+		backoff := baseBackoff << attempt
+		backoff += time.Duration(rand.Int63n(int64(backoff) / 2))
+		select {
+		case <-time.After(backoff):
+		case <-s.stop:
+			return false
+		}
+	}
+}
+
+func (s *Shipper) post(body []byte) (retry bool, err error) {
+	req, err := http.NewRequestWithContext(s.ctx, http.MethodPost, s.cfg.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	res.Body.Close()
+
+	switch {
+	case res.StatusCode < 300:
+		return false, nil
+	case res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= 500:
+		return true, fmt.Errorf("log service responded %d", res.StatusCode)
+	}
+	return false, fmt.Errorf("log service responded %d", res.StatusCode)
+}
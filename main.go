@@ -2,60 +2,334 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
 	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
 
-	"github.com/law-a-1/product-service/ent"
+	"github.com/law-a-1/product-service/auth"
+	"github.com/law-a-1/product-service/config"
 	"github.com/law-a-1/product-service/grpc"
+	"github.com/law-a-1/product-service/health"
+	"github.com/law-a-1/product-service/metrics"
+	"github.com/law-a-1/product-service/migrations"
+	"github.com/law-a-1/product-service/stock"
+	"github.com/law-a-1/product-service/storage"
+	"github.com/law-a-1/product-service/tlsconfig"
+	"github.com/law-a-1/product-service/tracing"
 	_ "github.com/lib/pq"
 	"go.uber.org/zap"
 )
 
 func main() {
-	logger, err := NewLogger()
+	cfg, args, err := config.Load(os.Args[0], os.Args[1:])
 	if err != nil {
-		logger.Fatalf("failed to create logger: %v", err)
-	}
-	defer func(logger *zap.SugaredLogger) {
-		err := logger.Sync()
-		if err != nil {
-			logger.Fatalf("failed to sync logger: %v", err)
+		if errors.Is(err, flag.ErrHelp) {
+			printCommands(os.Stderr)
+			return
 		}
-	}(logger)
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	logger, err := NewLogger(cfg.Log)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to create logger: %v\n", err)
+		os.Exit(1)
+	}
 	logger.Info("logger created")
+	NewLogShipper(cfg.Log)
 
-	persistent, err := NewPersistent(os.Getenv("DB_HOST"), os.Getenv("DB_PORT"), os.Getenv("DB_USER"), os.Getenv("DB_PASS"), os.Getenv("DB_NAME"))
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	if len(args) == 0 {
+		args = []string{"serve"}
+	}
+	if cmd, ok := commands[args[0]]; ok {
+		err = cmd.run(ctx, cfg, logger, args[1:])
+	} else {
+		printCommands(os.Stderr)
+		err = fmt.Errorf("unknown command %q", args[0])
+	}
 	if err != nil {
-		logger.Fatalf("failed to connect to database: %v", err)
+		logger.Errorf("%v", err)
+		_ = logger.Sync()
+		os.Exit(1)
 	}
-	defer func(persistent *ent.Client) {
-		err := persistent.Close()
-		if err != nil {
-			logger.Fatalf("failed to close database: %v", err)
+}
+
+// run starts the service and blocks until ctx is cancelled or a server
+// fails. It then turns readiness off, drains the HTTP and gRPC servers, stops
+// the background workers, flushes the traces, flushes and closes the logger and
+// closes the database, in that order.
+func run(ctx context.Context, cfg config.Config, logger *zap.SugaredLogger) error {
+	shutdownTracing, err := tracing.Setup(ctx, tracing.Config{
+		Service:     "products",
+		Exporter:    cfg.Tracing.Exporter,
+		Endpoint:    cfg.Tracing.Endpoint,
+		Insecure:    cfg.Tracing.Insecure,
+		SampleRatio: cfg.Tracing.SampleRatio,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set up tracing: %w", err)
+	}
+
+	db, err := OpenDB(cfg.DB)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	persistent := NewPersistent(db)
+	defer func() {
+		if err := persistent.Close(); err != nil {
+			logger.Errorf("failed to close database: %v", err)
 		}
-	}(persistent)
+	}()
 	logger.Info("database connected")
 
-	// Migrate database
-	if err := persistent.Schema.Create(context.Background()); err != nil {
-		logger.Fatalf("failed creating schema resources: %v", err)
+	// Migrations are applied by the migrate command, never by replicas
+	// racing at boot; refuse to serve an outdated schema.
+	migrator, err := migrations.New(db)
+	if err != nil {
+		return err
+	}
+	if err := migrator.Check(ctx); err != nil {
+		return fmt.Errorf("%w; run the migrate up command first", err)
+	}
+	logger.Info("database schema is up to date")
+
+	media, err := storage.NewLocal(cfg.Media.Dir, cfg.Media.BaseURL)
+	if err != nil {
+		return fmt.Errorf("failed to create media storage: %w", err)
+	}
+
+	authenticator, err := newAuthenticator(cfg.Auth)
+	if err != nil {
+		return fmt.Errorf("failed to configure authentication: %w", err)
+	}
+
+	policy := auth.DefaultPolicy()
+	if cfg.Auth.PolicyFile != "" {
+		if policy, err = auth.LoadPolicy(cfg.Auth.PolicyFile); err != nil {
+			return fmt.Errorf("failed to load authorization policy: %w", err)
+		}
+	}
+
+	m := metrics.New()
+	if err := m.RegisterDB(db, persistent); err != nil {
+		return fmt.Errorf("failed to register database metrics: %w", err)
+	}
+	if logShipper != nil {
+		if err := m.RegisterLogShipper(logShipper.Dropped); err != nil {
+			return fmt.Errorf("failed to register log shipping metrics: %w", err)
+		}
 	}
-	logger.Info("database migrated")
 
-	server := NewServer(logger, persistent)
+	checker := newChecker(cfg, logger, db, migrator)
+
+	server := NewServer(logger, persistent, media, authenticator, policy, m, checker)
 	server.SetupMiddlewares()
 	server.SetupRoutes()
 
-	grpcServer := grpc.NewServer(logger, persistent)
+	httpTLS, err := newTLSConfig(cfg.TLS, cfg.HTTP.ClientCAFile, true, logger, "h2", "http/1.1")
+	if err != nil {
+		return fmt.Errorf("failed to configure http tls: %w", err)
+	}
+	grpcTLS, err := newTLSConfig(cfg.TLS, cfg.GRPC.ClientCAFile, false, logger, "h2")
+	if err != nil {
+		return fmt.Errorf("failed to configure grpc tls: %w", err)
+	}
+
+	grpcOpts := []grpc.Option{
+		grpc.WithAuthorization(authenticator, policy),
+		grpc.WithIdempotencyRetention(cfg.GRPC.IdempotencyRetention),
+		grpc.WithIdempotencyLease(cfg.GRPC.IdempotencyLease),
+		grpc.WithMetrics(m),
+		grpc.WithHealth(checker.GRPC()),
+	}
+	if cfg.GRPC.CallersFile != "" {
+		callers, err := grpc.LoadCallers(cfg.GRPC.CallersFile)
+		if err != nil {
+			return fmt.Errorf("failed to load grpc callers: %w", err)
+		}
+		grpcOpts = append(grpcOpts, grpc.WithCallers(callers))
+	}
+	if grpcTLS != nil {
+		grpcOpts = append(grpcOpts, grpc.WithTLS(grpcTLS))
+	}
+	grpcServer := grpc.NewServer(logger, persistent, grpcOpts...)
+
+	// Background workers outlive the servers so that requests being drained
+	// can still rely on them.
+	workers, stopWorkers := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		checker.Run(workers, 5*time.Second)
+	}()
+	go func() {
+		defer wg.Done()
+		stock.RunSweeper(workers, persistent, logger, time.Minute)
+	}()
+	go func() {
+		defer wg.Done()
+		grpcServer.PurgeIdempotencyKeys(workers, time.Hour)
+	}()
 
+	errs := make(chan error, 2)
 	go func() {
-		if err := server.Start(); err != nil {
-			logger.Fatalf("failed to start server: %v", err)
+		logger.Infof("http server listening on port %d", cfg.HTTP.Port)
+		if err := server.Start(cfg.HTTP, httpTLS); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errs <- fmt.Errorf("http server: %w", err)
 		}
-		logger.Info("http server started")
 	}()
+	go func() {
+		logger.Infof("grpc server listening on port %d", cfg.GRPC.Port)
+		if err := grpcServer.Start(fmt.Sprintf(":%d", cfg.GRPC.Port)); err != nil {
+			errs <- fmt.Errorf("grpc server: %w", err)
+		}
+	}()
+
+	var serveErr error
+	select {
+	case <-ctx.Done():
+		logger.Info("shutting down")
+	case serveErr = <-errs:
+		logger.Errorf("shutting down: %v", serveErr)
+	}
+
+	checker.Shutdown()
+	if cfg.ShutdownDelay > 0 && serveErr == nil {
+		logger.Infof("not ready, draining in %s", cfg.ShutdownDelay)
+		time.Sleep(cfg.ShutdownDelay)
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		logger.Warnf("failed to drain http server: %v", err)
+	}
+	logger.Info("http server stopped")
+	grpcServer.Stop(shutdownCtx)
+	logger.Info("grpc server stopped")
+
+	stopWorkers()
+	wg.Wait()
+
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		logger.Warnf("failed to flush traces: %v", err)
+	}
+
+	if err := logger.Sync(); err != nil && !errors.Is(err, syscall.ENOTTY) && !errors.Is(err, syscall.EINVAL) {
+		fmt.Fprintf(os.Stderr, "failed to sync logger: %v\n", err)
+	}
+	if logShipper != nil {
+		if err := logShipper.Close(shutdownCtx); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to ship logs: %v\n", err)
+		}
+		if n := logShipper.Dropped(); n > 0 {
+			fmt.Fprintf(os.Stderr, "%d log entries were not shipped\n", n)
+		}
+	}
+	return serveErr
+}
+
+// newChecker returns the health checker of the service. Readiness requires
+// the database to answer at the latest schema version; the auth service is
+// checked when used, as an optional dependency. The log service is not
+// checked, since the log shipper rides out its outages.
+func newChecker(cfg config.Config, logger *zap.SugaredLogger, db *sql.DB, migrator *migrations.Migrator) *health.Checker {
+	checker := health.New(logger, grpc.Product_ServiceDesc.ServiceName)
+	checker.Require("database", db.PingContext)
+	checker.Require("migrations", migrator.Check)
+
+	// A hung dependency must not hold up a round of checks.
+	client := &http.Client{Timeout: 2 * time.Second}
+	if cfg.Auth.Mode == "remote" || cfg.Auth.Mode == "hybrid" {
+		checker.Optional("auth", health.HTTPCheck(client, cfg.Auth.IntrospectionURL))
+	}
+	if cfg.Auth.Mode != "remote" && cfg.Auth.JWKSURL != "" {
+		checker.Optional("jwks", health.HTTPCheck(client, cfg.Auth.JWKSURL))
+	}
+	return checker
+}
+
+// newTLSConfig returns the TLS configuration of a listener, or nil to serve
+// plaintext when no certificate is configured. Clients must present a
+// certificate signed by clientCA when requireClientCert is set; otherwise one
+// is only verified when given.
+func newTLSConfig(cfg config.TLS, clientCA string, requireClientCert bool, logger *zap.SugaredLogger, nextProtos ...string) (*tls.Config, error) {
+	if !cfg.Enabled() {
+		return nil, nil
+	}
+	reloader, err := tlsconfig.NewReloader(tlsconfig.Files{
+		Cert:              cfg.CertFile,
+		Key:               cfg.KeyFile,
+		ClientCA:          clientCA,
+		RequireClientCert: requireClientCert,
+	}, logger)
+	if err != nil {
+		return nil, err
+	}
+	return reloader.Config(nextProtos...), nil
+}
 
-	if err := grpcServer.Start(); err != nil {
-		logger.Fatalf("failed to start grpc server: %v", err)
+// newAuthenticator configures token verification for the auth mode: "remote"
+// introspects every token with the auth service, "jwt" verifies tokens
+// locally only, and "hybrid" verifies locally and falls back to the auth
+// service for tokens that cannot be verified locally.
+func newAuthenticator(cfg config.Auth) (auth.Authenticator, error) {
+	switch cfg.Mode {
+	case "remote":
+		return newIntrospector(cfg)
+	case "jwt":
+		return newJWTVerifier(cfg)
+	case "hybrid":
+		verifier, err := newJWTVerifier(cfg)
+		if err != nil {
+			return nil, err
+		}
+		introspector, err := newIntrospector(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return auth.Fallback(verifier, introspector), nil
 	}
-	logger.Info("grpc server started")
+	return nil, fmt.Errorf("unknown auth mode %q", cfg.Mode)
+}
+
+func newJWTVerifier(cfg config.Auth) (*auth.JWTVerifier, error) {
+	return auth.NewJWTVerifier(auth.JWTConfig{
+		Secret:      []byte(cfg.JWTSecret),
+		JWKSURL:     cfg.JWKSURL,
+		JWKSFile:    cfg.JWKSFile,
+		JWKSRefresh: cfg.JWKSRefresh,
+		Issuer:      cfg.JWTIssuer,
+		Audience:    cfg.JWTAudience,
+		Leeway:      30 * time.Second,
+		HTTPClient: &http.Client{
+			Timeout:   5 * time.Second,
+			Transport: tracing.Transport(nil),
+		},
+	})
+}
+
+func newIntrospector(cfg config.Auth) (*auth.Introspector, error) {
+	return auth.NewIntrospector(auth.IntrospectionConfig{
+		URL:      cfg.IntrospectionURL,
+		Timeout:  cfg.IntrospectionTimeout,
+		CacheTTL: cfg.CacheTTL,
+		HTTPClient: &http.Client{
+			Transport: tracing.Transport(nil),
+		},
+	})
 }
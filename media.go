@@ -0,0 +1,227 @@
+package main
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/law-a-1/product-service/ent/schema"
+	"github.com/law-a-1/product-service/imaging"
+)
+
+// maxUploadSize bounds the whole multipart body of a product form.
+const maxUploadSize = 60 << 20
+
+type mediaKind struct {
+	name    string
+	dir     string
+	maxSize int64
+	// types maps the accepted sniffed content types to file extensions.
+	types map[string]string
+}
+
+var (
+	imageMedia = mediaKind{
+		name:    "image",
+		dir:     "images",
+		maxSize: 5 << 20,
+		types: map[string]string{
+			"image/jpeg": ".jpg",
+			"image/png":  ".png",
+			"image/gif":  ".gif",
+			"image/webp": ".webp",
+		},
+	}
+	videoMedia = mediaKind{
+		name:    "video",
+		dir:     "videos",
+		maxSize: 50 << 20,
+		types: map[string]string{
+			"video/mp4":  ".mp4",
+			"video/webm": ".webm",
+		},
+	}
+)
+
+var (
+	errUploadTooLarge  = errors.New("uploaded file is too large")
+	errUnsupportedType = errors.New("uploaded file type is not supported")
+)
+
+// openUpload opens the file uploaded in the form field and returns it with
+// its kind, which must be one of kinds, and a fresh unique name to store it
+// under. The file is nil when nothing was uploaded. The content type is
+// sniffed from the file itself rather than trusted from the client.
+func openUpload(r *http.Request, field string, kinds ...mediaKind) (multipart.File, mediaKind, string, error) {
+	file, header, err := r.FormFile(field)
+	if err != nil {
+		if errors.Is(err, http.ErrMissingFile) {
+			return nil, mediaKind{}, "", nil
+		}
+		return nil, mediaKind{}, "", err
+	}
+
+	fail := func(err error) (multipart.File, mediaKind, string, error) {
+		file.Close()
+		return nil, mediaKind{}, "", err
+	}
+
+	sniff := make([]byte, 512)
+	n, err := io.ReadFull(file, sniff)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return fail(err)
+	}
+	contentType := http.DetectContentType(sniff[:n])
+	var kind mediaKind
+	var ext string
+	for _, k := range kinds {
+		if e, ok := k.types[contentType]; ok {
+			kind, ext = k, e
+			break
+		}
+	}
+	if ext == "" {
+		return fail(fmt.Errorf("%w: %s", errUnsupportedType, field))
+	}
+	if header.Size > kind.maxSize {
+		return fail(fmt.Errorf("%w: %s %s exceeds %d MB", errUploadTooLarge, kind.name, field, kind.maxSize>>20))
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return fail(err)
+	}
+
+	name, err := uniqueName()
+	if err != nil {
+		return fail(err)
+	}
+	return file, kind, kind.dir + "/" + name + ext, nil
+}
+
+// saveVideo stores the video uploaded in the form field and returns its URL,
+// or an empty URL when no video was uploaded.
+func (s Server) saveVideo(r *http.Request, field string) (string, error) {
+	file, _, name, err := openUpload(r, field, videoMedia)
+	if err != nil || file == nil {
+		return "", err
+	}
+	defer file.Close()
+
+	return s.storage.Save(r.Context(), name, file)
+}
+
+// saveImage stores the image uploaded in the form field together with its
+// resized variants and returns their URLs, or an empty URL when no image was
+// uploaded.
+func (s Server) saveImage(r *http.Request, field string) (string, map[string]schema.ImageVariant, error) {
+	file, _, name, err := openUpload(r, field, imageMedia)
+	if err != nil || file == nil {
+		return "", nil, err
+	}
+	defer file.Close()
+
+	return s.storeImage(r, file, name)
+}
+
+// storeImage stores an opened image upload under name together with its
+// resized variants.
+func (s Server) storeImage(r *http.Request, file multipart.File, name string) (string, map[string]schema.ImageVariant, error) {
+	files, err := imaging.Variants(file)
+	if err != nil {
+		if errors.Is(err, imaging.ErrUnsupportedImage) || errors.Is(err, imaging.ErrImageTooLarge) {
+			return "", nil, fmt.Errorf("%w: %v", errUnsupportedType, err)
+		}
+		return "", nil, err
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", nil, err
+	}
+
+	image, err := s.storage.Save(r.Context(), name, file)
+	if err != nil {
+		return "", nil, err
+	}
+
+	variants := make(map[string]schema.ImageVariant, len(imaging.Sizes))
+	base := strings.TrimSuffix(name, path.Ext(name))
+	for _, f := range files {
+		url, err := s.storage.Save(r.Context(), base+"_"+f.Size+f.Ext, bytes.NewReader(f.Data))
+		if err != nil {
+			s.removeMedia(r, append(variantURLs(variants), image)...)
+			return "", nil, err
+		}
+
+		v := variants[f.Size]
+		v.Width, v.Height = f.Width, f.Height
+		if f.Ext == ".webp" {
+			v.WebP = url
+		} else {
+			v.URL = url
+		}
+		variants[f.Size] = v
+	}
+	return image, variants, nil
+}
+
+// saveUploads stores the image and video of a product form. On error, files
+// already stored are removed again.
+func (s Server) saveUploads(r *http.Request) (image string, variants map[string]schema.ImageVariant, video string, err error) {
+	image, variants, err = s.saveImage(r, "image")
+	if err != nil {
+		return "", nil, "", err
+	}
+	video, err = s.saveVideo(r, "video")
+	if err != nil {
+		s.removeMedia(r, append(variantURLs(variants), image)...)
+		return "", nil, "", err
+	}
+	return image, variants, video, nil
+}
+
+// variantURLs lists the URLs of every stored file of variants.
+func variantURLs(variants map[string]schema.ImageVariant) []string {
+	var urls []string
+	for _, v := range variants {
+		urls = append(urls, v.URL, v.WebP)
+	}
+	return urls
+}
+
+// removeMedia deletes stored files, logging failures since the product change
+// they belong to already happened.
+func (s Server) removeMedia(r *http.Request, urls ...string) {
+	for _, url := range urls {
+		if url == "" {
+			continue
+		}
+		if err := s.storage.Delete(r.Context(), url); err != nil {
+			s.log(r).Warnf("failed to remove media %s: %v", url, err)
+		}
+	}
+}
+
+// uploadError writes the response for an error of saveUploads.
+func uploadError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, errUploadTooLarge):
+		JSON(w, http.StatusRequestEntityTooLarge, nil, err.Error())
+	case errors.Is(err, errUnsupportedType):
+		JSON(w, http.StatusUnsupportedMediaType, nil, err.Error())
+	default:
+		JSON(w, http.StatusInternalServerError, nil, "failed to store uploaded file")
+	}
+}
+
+func uniqueName() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,180 @@
+// Package metrics exposes Prometheus metrics of the HTTP and gRPC servers, the
+// database pool and the catalog.
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/law-a-1/product-service/ent"
+	"github.com/law-a-1/product-service/ent/product"
+	"github.com/law-a-1/product-service/ent/reservation"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// queryTimeout bounds the queries run for a scrape.
+const queryTimeout = 5 * time.Second
+
+// Metrics holds the collectors of the service.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	httpRequests *prometheus.CounterVec
+	httpDuration *prometheus.HistogramVec
+	grpcCalls    *prometheus.CounterVec
+	grpcDuration *prometheus.HistogramVec
+}
+
+// New returns Metrics registering the server metrics along with the Go
+// runtime and process metrics.
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "http_requests_total",
+			Help: "HTTP requests by method, route pattern and status.",
+		}, []string{"method", "route", "status"}),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "http_request_duration_seconds",
+			Help:    "Latency of HTTP requests by method and route pattern.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method", "route"}),
+		grpcCalls: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "grpc_server_handled_total",
+			Help: "gRPC calls by method and status code.",
+		}, []string{"grpc_method", "grpc_code"}),
+		grpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "grpc_server_handling_seconds",
+			Help:    "Latency of gRPC calls by method.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"grpc_method"}),
+	}
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpRequests,
+		m.httpDuration,
+		m.grpcCalls,
+		m.grpcDuration,
+	)
+	return m
+}
+
+// RegisterDB adds the statistics of the db pool and the catalog gauges
+// queried through client, which must use db.
+func (m *Metrics) RegisterDB(db *sql.DB, client *ent.Client) error {
+	if err := m.registry.Register(collectors.NewDBStatsCollector(db, "products")); err != nil {
+		return err
+	}
+	return m.registry.Register(&catalogCollector{client: client})
+}
+
+// RegisterLogShipper adds the number of log entries the log shipper dropped,
+// as reported by dropped.
+func (m *Metrics) RegisterLogShipper(dropped func() uint64) error {
+	return m.registry.Register(prometheus.NewCounterFunc(prometheus.CounterOpts{
+		Name: "log_entries_dropped_total",
+		Help: "Log entries dropped because the queue was full or the log service failed.",
+	}, func() float64 { return float64(dropped()) }))
+}
+
+// Handler serves the metrics in the Prometheus exposition format. Metrics
+// that fail to be collected, such as the catalog gauges while the database is
+// down, are left out rather than failing the scrape.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{ErrorHandling: promhttp.ContinueOnError})
+}
+
+// Middleware measures the requests served by a chi router. Requests matching
+// no route share the route label "unmatched".
+func (m *Metrics) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		next.ServeHTTP(ww, r)
+
+		route := "unmatched"
+		if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+			route = rctx.RoutePattern()
+		}
+		code := ww.Status()
+		if code == 0 {
+			code = http.StatusOK
+		}
+		m.httpRequests.WithLabelValues(r.Method, route, strconv.Itoa(code)).Inc()
+		m.httpDuration.WithLabelValues(r.Method, route).Observe(time.Since(start).Seconds())
+	})
+}
+
+// UnaryServerInterceptor measures unary gRPC calls.
+func (m *Metrics) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	res, err := handler(ctx, req)
+	m.observeCall(info.FullMethod, start, err)
+	return res, err
+}
+
+// StreamServerInterceptor measures streaming gRPC calls.
+func (m *Metrics) StreamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	m.observeCall(info.FullMethod, start, err)
+	return err
+}
+
+func (m *Metrics) observeCall(method string, start time.Time, err error) {
+	m.grpcCalls.WithLabelValues(method, status.Code(err).String()).Inc()
+	m.grpcDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
+
+var (
+	productsDesc = prometheus.NewDesc("products_total",
+		"Products in the catalog.", nil, nil)
+	outOfStockDesc = prometheus.NewDesc("products_out_of_stock",
+		"Products without stock.", nil, nil)
+	reservationsDesc = prometheus.NewDesc("reservations_active",
+		"Stock reservations neither confirmed, cancelled nor expired.", nil, nil)
+)
+
+// catalogCollector queries the catalog gauges on every scrape.
+type catalogCollector struct {
+	client *ent.Client
+}
+
+func (c *catalogCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- productsDesc
+	ch <- outOfStockDesc
+	ch <- reservationsDesc
+}
+
+func (c *catalogCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), queryTimeout)
+	defer cancel()
+
+	gauge := func(desc *prometheus.Desc, count func() (int, error)) {
+		n, err := count()
+		if err != nil {
+			ch <- prometheus.NewInvalidMetric(desc, err)
+			return
+		}
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, float64(n))
+	}
+	gauge(productsDesc, func() (int, error) {
+		return c.client.Product.Query().Count(ctx)
+	})
+	gauge(outOfStockDesc, func() (int, error) {
+		return c.client.Product.Query().Where(product.StockLTE(0)).Count(ctx)
+	})
+	gauge(reservationsDesc, func() (int, error) {
+		return c.client.Reservation.Query().Where(reservation.StatusEQ(reservation.StatusActive)).Count(ctx)
+	})
+}
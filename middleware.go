@@ -2,26 +2,30 @@ package main
 
 import (
 	"context"
-	"encoding/json"
-	"io"
+	"errors"
 	"net/http"
 	"strings"
 
 	"github.com/go-chi/chi/v5/middleware"
+	"github.com/law-a-1/product-service/auth"
+	"github.com/law-a-1/product-service/logging"
+	"github.com/law-a-1/product-service/tracing"
 )
 
 func (s Server) SetupMiddlewares() {
-	s.router.Use(middleware.Heartbeat("/health"))
+	s.router.Use(s.health.Middleware)
+	s.router.Use(tracing.Middleware)
+	s.router.Use(s.metrics.Middleware)
 	s.router.Use(middleware.CleanPath)
 	s.router.Use(middleware.AllowContentType("application/json", "multipart/form-data"))
-	s.router.Use(middleware.RequestLogger(&SugaredRequestLogger{Logger: s.logger}))
+	s.router.Use(s.RequestLogger)
 
 	s.router.Use(middleware.SetHeader("Content-Type", "application/json; charset=utf-8"))
 
 	s.router.Use(middleware.Recoverer)
 }
 
-func IsAuthorized(next http.Handler) http.Handler {
+func (s Server) IsAuthorized(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		reqToken := r.Header.Get("Authorization")
 		if reqToken == "" {
@@ -41,50 +45,47 @@ func IsAuthorized(next http.Handler) http.Handler {
 		}
 		reqToken = splitToken[1]
 
-		req, err := http.NewRequest("GET", "https://auth-law-a1.herokuapp.com/user", nil)
+		user, err := s.authenticator.Authenticate(r.Context(), reqToken)
 		if err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-			return
-		}
-		req.Header.Add("Content-Type", "application/json")
-		req.Header.Add("Authorization", "Bearer "+reqToken)
-
-		res, err := http.DefaultClient.Do(req)
-		if err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-			return
-		}
-
-		defer func(Body io.ReadCloser) {
-			err := Body.Close()
-			if err != nil {
+			switch {
+			case errors.Is(err, auth.ErrInvalidToken), errors.Is(err, auth.ErrUnverifiable):
+				s.log(r).Debugf("rejected token: %v", err)
+				w.WriteHeader(http.StatusUnauthorized)
+			case errors.Is(err, auth.ErrUnavailable):
+				s.log(r).Warnf("failed to authenticate: %v", err)
+				w.WriteHeader(http.StatusServiceUnavailable)
+			default:
+				s.log(r).Errorf("failed to authenticate: %v", err)
 				w.WriteHeader(http.StatusInternalServerError)
-				return
 			}
-		}(res.Body)
-
-		if res.StatusCode != http.StatusOK {
-			w.WriteHeader(http.StatusUnauthorized)
 			return
 		}
 
-		var user userResponse
-		if err := json.NewDecoder(res.Body).Decode(&user); err != nil {
-			w.WriteHeader(http.StatusBadRequest)
-			return
+		if entry, ok := middleware.GetLogEntry(r).(*requestLogEntry); ok {
+			entry.userID = &user.ID
 		}
-
-		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), "user", user)))
+		ctx := context.WithValue(r.Context(), "user", user)
+		ctx = logging.NewContext(ctx, s.log(r).With("user_id", user.ID))
+		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
-func IsAdmin(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		u := r.Context().Value("user").(userResponse)
-		// TODO check if user is admin
-		if u.Role != "user" {
-			w.WriteHeader(http.StatusForbidden)
-		}
-		next.ServeHTTP(w, r)
-	})
+// Require rejects requests of users whose role is not granted perm. It must
+// run after IsAuthorized.
+func (s Server) Require(perm auth.Permission) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !s.allowed(r, perm) {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// allowed reports whether the authorized user of r is granted perm.
+func (s Server) allowed(r *http.Request, perm auth.Permission) bool {
+	u, ok := r.Context().Value("user").(auth.User)
+	return ok && s.policy.Allowed(u.Role, perm)
 }
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "stock_movements";
+DROP TABLE IF EXISTS "reservations";
+DROP TABLE IF EXISTS "product_media";
+DROP TABLE IF EXISTS "products";
+DROP TABLE IF EXISTS "idempotency_keys";
@@ -0,0 +1,34 @@
+-- Tables of the ent schema. IF NOT EXISTS lets databases created by the
+-- former Schema.Create at boot adopt this migration as their baseline.
+CREATE TABLE IF NOT EXISTS "idempotency_keys"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "key" varchar NOT NULL, "method" varchar NOT NULL, "request_hash" varchar NOT NULL, "state" varchar NOT NULL DEFAULT 'pending', "response" bytea NULL, "status" bytea NULL, "created_at" timestamp with time zone NOT NULL, "expires_at" timestamp with time zone NOT NULL, PRIMARY KEY("id"));
+CREATE UNIQUE INDEX IF NOT EXISTS "idempotencykey_method_key" ON "idempotency_keys"("method", "key");
+CREATE INDEX IF NOT EXISTS "idempotencykey_expires_at" ON "idempotency_keys"("expires_at");
+CREATE TABLE IF NOT EXISTS "products"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "name" varchar UNIQUE NOT NULL, "description" varchar NOT NULL, "price" bigint NOT NULL, "stock" bigint NOT NULL, "image" varchar NULL, "video" varchar NULL, "created_at" timestamp with time zone NOT NULL, "updated_at" timestamp with time zone NOT NULL, PRIMARY KEY("id"));
+-- The products table predates the other tables, so databases at the baseline
+-- have it without the columns added since.
+ALTER TABLE "products" ADD COLUMN IF NOT EXISTS "image_variants" jsonb NULL;
+CREATE TABLE IF NOT EXISTS "product_media"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "kind" varchar NOT NULL, "url" varchar NOT NULL, "variants" jsonb NULL, "alt_text" varchar NULL, "position" bigint NOT NULL, "is_primary" boolean NOT NULL DEFAULT false, "created_at" timestamp with time zone NOT NULL, "product_id" bigint NOT NULL, PRIMARY KEY("id"));
+CREATE INDEX IF NOT EXISTS "productmedia_product_id_position" ON "product_media"("product_id", "position");
+CREATE TABLE IF NOT EXISTS "reservations"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "quantity" bigint NOT NULL, "owner" varchar NOT NULL, "status" varchar NOT NULL DEFAULT 'active', "expires_at" timestamp with time zone NOT NULL, "created_at" timestamp with time zone NOT NULL, "updated_at" timestamp with time zone NOT NULL, "product_id" bigint NOT NULL, PRIMARY KEY("id"));
+CREATE INDEX IF NOT EXISTS "reservation_product_id_status" ON "reservations"("product_id", "status");
+CREATE INDEX IF NOT EXISTS "reservation_status_expires_at" ON "reservations"("status", "expires_at");
+CREATE TABLE IF NOT EXISTS "stock_movements"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "delta" bigint NOT NULL, "stock_after" bigint NOT NULL, "reason" varchar NOT NULL, "actor" varchar NULL, "reference_id" varchar NULL, "created_at" timestamp with time zone NOT NULL, "product_id" bigint NULL, PRIMARY KEY("id"));
+CREATE INDEX IF NOT EXISTS "stockmovement_product_id_created_at" ON "stock_movements"("product_id", "created_at");
+DO $$ BEGIN
+	ALTER TABLE "product_media" ADD CONSTRAINT "product_media_products_media" FOREIGN KEY("product_id") REFERENCES "products"("id") ON DELETE CASCADE;
+EXCEPTION WHEN duplicate_object THEN NULL;
+END $$;
+DO $$ BEGIN
+	ALTER TABLE "reservations" ADD CONSTRAINT "reservations_products_reservations" FOREIGN KEY("product_id") REFERENCES "products"("id") ON DELETE CASCADE;
+EXCEPTION WHEN duplicate_object THEN NULL;
+END $$;
+DO $$ BEGIN
+	ALTER TABLE "stock_movements" ADD CONSTRAINT "stock_movements_products_movements" FOREIGN KEY("product_id") REFERENCES "products"("id") ON DELETE SET NULL;
+EXCEPTION WHEN duplicate_object THEN NULL;
+END $$;
+-- Products of databases at the baseline have no ledger yet. Open it with their
+-- current stock so that it reconciles.
+INSERT INTO "stock_movements" ("delta", "stock_after", "reason", "actor", "created_at", "product_id")
+SELECT "p"."stock", "p"."stock", 'initial', 'migration', now(), "p"."id"
+FROM "products" AS "p"
+WHERE NOT EXISTS (SELECT 1 FROM "stock_movements" AS "m" WHERE "m"."product_id" = "p"."id");
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS "idempotencykey_caller_method_key";
+-- Keys of different callers may collide under the old index. The records are
+-- short-lived, so they are dropped instead.
+DELETE FROM "idempotency_keys";
+CREATE UNIQUE INDEX IF NOT EXISTS "idempotencykey_method_key" ON "idempotency_keys"("method", "key");
+ALTER TABLE "idempotency_keys" DROP COLUMN IF EXISTS "caller";
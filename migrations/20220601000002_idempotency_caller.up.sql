@@ -0,0 +1,4 @@
+-- Scope idempotency keys to the caller that used them.
+ALTER TABLE "idempotency_keys" ADD COLUMN IF NOT EXISTS "caller" varchar NOT NULL DEFAULT '';
+DROP INDEX IF EXISTS "idempotencykey_method_key";
+CREATE UNIQUE INDEX IF NOT EXISTS "idempotencykey_caller_method_key" ON "idempotency_keys"("caller", "method", "key");
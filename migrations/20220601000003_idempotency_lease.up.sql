@@ -0,0 +1,3 @@
+-- Let retries take over keys claimed by calls that were lost.
+ALTER TABLE "idempotency_keys" ADD COLUMN IF NOT EXISTS "claimed_at" timestamp with time zone NOT NULL DEFAULT now();
+ALTER TABLE "idempotency_keys" ALTER COLUMN "claimed_at" DROP DEFAULT;
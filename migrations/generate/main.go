@@ -0,0 +1,120 @@
+// Command generate writes a versioned migration from the changes of the ent
+// schema. It brings the development database at -dev-url to the latest
+// migration, diffs it against the schema and writes the statements into the
+// migrations directory:
+//
+//	go run ./migrations/generate -dev-url postgres://localhost/products_dev <name>
+//
+// The generated files must be reviewed before they are committed.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"log"
+
+	atlas "ariga.io/atlas/sql/migrate"
+	atschema "ariga.io/atlas/sql/schema"
+	"ariga.io/atlas/sql/sqltool"
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+	_ "github.com/jackc/pgx/v4/stdlib"
+	"github.com/law-a-1/product-service/ent/migrate"
+	"github.com/law-a-1/product-service/migrations"
+)
+
+// unmanaged are the columns and indexes created by hand-written migrations,
+// which the ent schema does not know of and must not drop.
+var unmanaged = map[string]bool{
+	"search_vector":                 true,
+	"products_search_vector_idx":    true,
+	"products_name_trgm_idx":        true,
+	"products_description_trgm_idx": true,
+}
+
+func main() {
+	devURL := flag.String("dev-url", "", "url of a development database the migrations may be applied to")
+	dir := flag.String("dir", "migrations", "migrations directory")
+	flag.Parse()
+	if *devURL == "" || flag.NArg() != 1 {
+		log.Fatalln("usage: generate -dev-url <url> <name>")
+	}
+	ctx := context.Background()
+
+	db, err := sql.Open("pgx", *devURL)
+	if err != nil {
+		log.Fatalf("opening dev database: %v", err)
+	}
+	defer db.Close()
+
+	migrator, err := migrations.New(db)
+	if err != nil {
+		log.Fatalf("loading migrations: %v", err)
+	}
+	if _, err := migrator.Up(ctx); err != nil {
+		log.Fatalf("migrating dev database: %v", err)
+	}
+
+	local, err := atlas.NewLocalDir(*dir)
+	if err != nil {
+		log.Fatalf("opening migrations directory: %v", err)
+	}
+	m, err := schema.NewMigrate(
+		entsql.OpenDB(dialect.Postgres, db),
+		schema.WithAtlas(true),
+		schema.WithDir(local),
+		schema.WithFormatter(sqltool.GolangMigrateFormatter),
+		schema.WithDropColumn(true),
+		schema.WithDropIndex(true),
+		schema.WithDiffHook(keepUnmanaged),
+	)
+	if err != nil {
+		log.Fatalf("creating migrate: %v", err)
+	}
+	if err := m.NamedDiff(ctx, flag.Arg(0), migrate.Tables...); err != nil {
+		log.Fatalf("generating migration: %v", err)
+	}
+}
+
+// keepUnmanaged drops the changes removing unmanaged objects and the
+// migrations table.
+func keepUnmanaged(next schema.Differ) schema.Differ {
+	return schema.DiffFunc(func(current, desired *atschema.Schema) ([]atschema.Change, error) {
+		changes, err := next.Diff(current, desired)
+		if err != nil {
+			return nil, err
+		}
+		kept := changes[:0]
+		for _, c := range changes {
+			switch c := c.(type) {
+			case *atschema.DropTable:
+				if c.T.Name == "schema_migrations" {
+					continue
+				}
+			case *atschema.ModifyTable:
+				inner := c.Changes[:0]
+				for _, tc := range c.Changes {
+					switch tc := tc.(type) {
+					case *atschema.DropColumn:
+						if unmanaged[tc.C.Name] {
+							continue
+						}
+					case *atschema.DropIndex:
+						if unmanaged[tc.I.Name] {
+							continue
+						}
+					}
+					inner = append(inner, tc)
+				}
+				if len(inner) == 0 {
+					continue
+				}
+				c.Changes = inner
+			}
+			kept = append(kept, c)
+		}
+		return kept, nil
+	})
+}
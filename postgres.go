@@ -4,22 +4,27 @@ import (
 	"database/sql"
 	"entgo.io/ent/dialect"
 	entsql "entgo.io/ent/dialect/sql"
-	"fmt"
 	_ "github.com/jackc/pgx/v4/stdlib"
+	"github.com/law-a-1/product-service/config"
 	"github.com/law-a-1/product-service/ent"
-	"time"
+	"github.com/law-a-1/product-service/tracing"
 )
 
-func NewPersistent(host, port, user, password, name string) (*ent.Client, error) {
-	db, err := sql.Open("pgx", fmt.Sprintf("host=%s port=%s user=%s dbname=%s password=%s sslmode=disable", host, port, user, name, password))
+// OpenDB opens the connection pool of the database.
+func OpenDB(cfg config.DB) (*sql.DB, error) {
+	db, err := sql.Open("pgx", cfg.DSN())
 	if err != nil {
 		return nil, err
 	}
 
-	db.SetMaxOpenConns(25)
-	db.SetMaxIdleConns(25)
-	db.SetConnMaxLifetime(5 * time.Minute)
+	db.SetMaxOpenConns(cfg.MaxOpenConns)
+	db.SetMaxIdleConns(cfg.MaxIdleConns)
+	db.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+	return db, nil
+}
 
+func NewPersistent(db *sql.DB) *ent.Client {
 	drv := entsql.OpenDB(dialect.Postgres, db)
-	return ent.NewClient(ent.Driver(drv)), nil
+	return ent.NewClient(ent.Driver(tracing.Driver(drv)))
 }
@@ -1,51 +1,187 @@
 package main
 
 import (
-	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/go-chi/chi/v5"
+	"github.com/law-a-1/product-service/auth"
+	"github.com/law-a-1/product-service/catalog"
+	"github.com/law-a-1/product-service/config"
 	"github.com/law-a-1/product-service/ent"
 	"github.com/law-a-1/product-service/ent/product"
+	"github.com/law-a-1/product-service/ent/productmedia"
+	"github.com/law-a-1/product-service/ent/schema"
+	"github.com/law-a-1/product-service/ent/stockmovement"
+	"github.com/law-a-1/product-service/health"
+	"github.com/law-a-1/product-service/logship"
+	"github.com/law-a-1/product-service/metrics"
+	"github.com/law-a-1/product-service/stock"
+	"github.com/law-a-1/product-service/storage"
 	"go.uber.org/zap"
 	"net/http"
-	"os"
+	"net/url"
 	"strconv"
+	"time"
 )
 
 type Server struct {
-	router *chi.Mux
-	db     *ent.Client
-	logger *zap.SugaredLogger
+	router        *chi.Mux
+	httpServer    *http.Server
+	db            *ent.Client
+	logger        *zap.SugaredLogger
+	storage       storage.Storage
+	authenticator auth.Authenticator
+	policy        *auth.Policy
+	metrics       *metrics.Metrics
+	health        *health.Checker
 }
 
-func NewServer(logger *zap.SugaredLogger, db *ent.Client) Server {
+func NewServer(logger *zap.SugaredLogger, db *ent.Client, storage storage.Storage, authenticator auth.Authenticator, policy *auth.Policy, metrics *metrics.Metrics, health *health.Checker) Server {
 	return Server{
-		router: chi.NewRouter(),
-		db:     db,
-		logger: logger,
+		router:        chi.NewRouter(),
+		httpServer:    &http.Server{},
+		db:            db,
+		logger:        logger,
+		storage:       storage,
+		authenticator: authenticator,
+		policy:        policy,
+		metrics:       metrics,
+		health:        health,
 	}
 }
 
 type productsResponse struct {
-	Products []productResponse `json:"products"`
-	Count    int               `json:"count"`
+	Products   []productResponse `json:"products"`
+	Count      int               `json:"count"`
+	NextCursor string            `json:"next_cursor,omitempty"`
 }
 
 type productResponse struct {
-	ID          int    `json:"id"`
+	ID          int             `json:"id"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Price       int             `json:"price"`
+	Stock       int             `json:"stock"`
+	Available   int             `json:"available"`
+	Image       string          `json:"image"`
+	Images      *imagesResponse `json:"images,omitempty"`
+	Video       string          `json:"video"`
+	Gallery     []mediaResponse `json:"gallery"`
+}
+
+type mediaResponse struct {
+	ID        int                            `json:"id"`
+	Kind      string                         `json:"kind"`
+	URL       string                         `json:"url"`
+	Variants  map[string]schema.ImageVariant `json:"variants,omitempty"`
+	AltText   string                         `json:"alt_text"`
+	Position  int                            `json:"position"`
+	IsPrimary bool                           `json:"is_primary"`
+}
+
+type mediaOrderRequest struct {
+	IDs []int `json:"ids"`
+}
+
+type mediaChangeRequest struct {
+	AltText   *string `json:"alt_text"`
+	IsPrimary *bool   `json:"is_primary"`
+}
+
+type imagesResponse struct {
+	Original  string               `json:"original"`
+	Large     *schema.ImageVariant `json:"large,omitempty"`
+	Medium    *schema.ImageVariant `json:"medium,omitempty"`
+	Thumbnail *schema.ImageVariant `json:"thumbnail,omitempty"`
+}
+
+func newProductResponse(item catalog.Item) productResponse {
+	res := productResponse{
+		ID:          item.ID,
+		Name:        item.Name,
+		Description: item.Description,
+		Price:       item.Price,
+		Stock:       item.Stock,
+		Available:   item.Available,
+		Image:       item.Image,
+		Video:       item.Video,
+		Gallery:     []mediaResponse{},
+	}
+	for _, m := range item.Edges.Media {
+		res.Gallery = append(res.Gallery, newMediaResponse(m))
+	}
+	if item.Image != "" {
+		res.Images = &imagesResponse{Original: item.Image}
+		if v, ok := item.ImageVariants["large"]; ok {
+			res.Images.Large = &v
+		}
+		if v, ok := item.ImageVariants["medium"]; ok {
+			res.Images.Medium = &v
+		}
+		if v, ok := item.ImageVariants["thumbnail"]; ok {
+			res.Images.Thumbnail = &v
+		}
+	}
+	return res
+}
+
+type searchHighlightsResponse struct {
 	Name        string `json:"name"`
 	Description string `json:"description"`
-	Price       int    `json:"price"`
-	Stock       int    `json:"stock"`
-	Image       string `json:"image"`
-	Video       string `json:"video"`
 }
 
-type userResponse struct {
-	Username string `json:"username"`
-	ID       int    `json:"id"`
-	Role     string `json:"role"`
+type searchResultResponse struct {
+	productResponse
+	Rank       float64                  `json:"rank"`
+	Highlights searchHighlightsResponse `json:"highlights"`
+}
+
+type searchResponse struct {
+	Results []searchResultResponse `json:"results"`
+	Count   int                    `json:"count"`
+	Fuzzy   bool                   `json:"fuzzy"`
+}
+
+type stockChangeRequest struct {
+	Delta       int    `json:"delta"`
+	Reason      string `json:"reason"`
+	ReferenceID string `json:"reference_id"`
+}
+
+type stockResponse struct {
+	Stock int `json:"stock"`
+}
+
+type stockMovementResponse struct {
+	ID          int       `json:"id"`
+	Delta       int       `json:"delta"`
+	StockAfter  int       `json:"stock_after"`
+	Reason      string    `json:"reason"`
+	Actor       string    `json:"actor"`
+	ReferenceID string    `json:"reference_id"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type stockLedgerResponse struct {
+	Stock      int                     `json:"stock"`
+	Ledger     int                     `json:"ledger"`
+	Reconciled bool                    `json:"reconciled"`
+	Movements  []stockMovementResponse `json:"movements"`
+}
+
+func newMediaResponse(m *ent.ProductMedia) mediaResponse {
+	return mediaResponse{
+		ID:        m.ID,
+		Kind:      string(m.Kind),
+		URL:       m.URL,
+		Variants:  m.Variants,
+		AltText:   m.AltText,
+		Position:  m.Position,
+		IsPrimary: m.IsPrimary,
+	}
 }
 
 type errorResponse struct {
@@ -62,35 +198,88 @@ func (s Server) SetupRoutes() {
 		w.Write([]byte("method is not valid"))
 	})
 
+	s.router.Method(http.MethodGet, "/metrics", s.metrics.Handler())
+
 	s.router.Route("/products", func(r chi.Router) {
 		r.Get("/", func(w http.ResponseWriter, r *http.Request) {
-			products, err := s.db.Product.
-				Query().
-				All(r.Context())
+			params, err := listParams(r.URL.Query())
+			if err != nil {
+				JSON(w, http.StatusBadRequest, nil, err.Error())
+				return
+			}
+
+			page, err := catalog.List(r.Context(), s.db, params)
 			if err != nil {
+				if errors.Is(err, catalog.ErrInvalidCursor) || errors.Is(err, catalog.ErrInvalidSort) {
+					JSON(w, http.StatusBadRequest, nil, err.Error())
+					return
+				}
+				s.log(r).Errorf("failed to get all products: %v", err)
 				JSON(w, http.StatusInternalServerError, nil, "failed to get all products")
 				return
 			}
 
 			var productsResponse productsResponse
-			for _, p := range products {
-				productResponse := productResponse{
-					ID:          p.ID,
-					Name:        p.Name,
-					Description: p.Description,
-					Price:       p.Price,
-					Stock:       p.Stock,
-					Image:       p.Image,
-					Video:       p.Video,
-				}
-				productsResponse.Products = append(productsResponse.Products, productResponse)
+			for _, item := range page.Items {
+				productsResponse.Products = append(productsResponse.Products, newProductResponse(item))
 			}
-			productsResponse.Count = len(productsResponse.Products)
+			productsResponse.Count = page.Total
+			productsResponse.NextCursor = page.NextCursor
 
 			JSON(w, http.StatusOK, productsResponse, "All Products fetched")
 		})
 
-		r.With(IsAuthorized, IsAdmin).Post("/", func(w http.ResponseWriter, r *http.Request) {
+		r.Get("/search", func(w http.ResponseWriter, r *http.Request) {
+			params := catalog.SearchParams{Query: r.URL.Query().Get("q")}
+			if v := r.URL.Query().Get("limit"); v != "" {
+				limit, err := strconv.Atoi(v)
+				if err != nil || limit < 1 || limit > catalog.MaxPageSize {
+					JSON(w, http.StatusBadRequest, nil, fmt.Sprintf("limit must be between 1 and %d", catalog.MaxPageSize))
+					return
+				}
+				params.Limit = limit
+			}
+			if v := r.URL.Query().Get("offset"); v != "" {
+				offset, err := strconv.Atoi(v)
+				if err != nil || offset < 0 {
+					JSON(w, http.StatusBadRequest, nil, "failed to parse offset value")
+					return
+				}
+				params.Offset = offset
+			}
+
+			result, err := catalog.Search(r.Context(), s.db, params)
+			if err != nil {
+				if errors.Is(err, catalog.ErrEmptyQuery) {
+					JSON(w, http.StatusBadRequest, nil, err.Error())
+					return
+				}
+				s.log(r).Errorf("failed to search products: %v", err)
+				JSON(w, http.StatusInternalServerError, nil, "failed to search products")
+				return
+			}
+
+			res := searchResponse{
+				Results: []searchResultResponse{},
+				Count:   result.Total,
+				Fuzzy:   result.Fuzzy,
+			}
+			for _, h := range result.Hits {
+				res.Results = append(res.Results, searchResultResponse{
+					productResponse: newProductResponse(h.Item),
+					Rank:            h.Rank,
+					Highlights: searchHighlightsResponse{
+						Name:        h.NameHighlight,
+						Description: h.DescriptionHighlight,
+					},
+				})
+			}
+
+			JSON(w, http.StatusOK, res, "Products searched")
+		})
+
+		r.With(s.IsAuthorized, s.Require(auth.ProductCreate)).Post("/", func(w http.ResponseWriter, r *http.Request) {
+			r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize)
 			if err := r.ParseMultipartForm(5 << 20); err != nil {
 				JSON(w, http.StatusBadRequest, nil, "failed to parse multipart form")
 				return
@@ -102,85 +291,46 @@ func (s Server) SetupRoutes() {
 				return
 			}
 
-			stock, err := strconv.Atoi(r.FormValue("stock"))
+			quantity, err := strconv.Atoi(r.FormValue("stock"))
 			if err != nil {
 				JSON(w, http.StatusBadRequest, nil, "failed to parse stock value")
 				return
 			}
 
-			var image string
-			var video string
-
-			// image, imageHeader, err := r.FormFile("image")
-			// if err != nil {
-			// 	log.Fatal(err)
-			// 	panic("error image")
-			// }
-			// defer image.Close()
-
-			// video, videoHeader, err := r.FormFile("video")
-			// if err != nil {
-			// 	panic("error video")
-			// }
-			// defer video.Close()
-
-			// Create the assets folder if it doesn't
-			// already exist
-			// err = os.MkdirAll("./assets", os.ModePerm)
-			// if err != nil {
-			// 	http.Error(w, err.Error(), http.StatusInternalServerError)
-			// 	return
-			// }
-
-			// // Create a new file in the assets directory
-			// imageDst, err := os.Create(fmt.Sprintf("./assets/%d%s", time.Now().UnixNano(), filepath.Ext(imageHeader.Filename)))
-			// if err != nil {
-			// 	http.Error(w, err.Error(), http.StatusInternalServerError)
-			// 	return
-			// }
-			// defer imageDst.Close()
-
-			// // Copy the uploaded file to the filesystem
-			// // at the specified destination
-			// _, err = io.Copy(imageDst, image)
-			// if err != nil {
-			// 	http.Error(w, err.Error(), http.StatusInternalServerError)
-			// 	return
-			// }
-
-			// videoDst, err := os.Create(fmt.Sprintf("./assets/%d%s", time.Now().UnixNano(), filepath.Ext(videoHeader.Filename)))
-			// if err != nil {
-			// 	http.Error(w, err.Error(), http.StatusInternalServerError)
-			// 	return
-			// }
-			// defer videoDst.Close()
-
-			// // Copy the uploaded file to the filesystem
-			// // at the specified destination
-			// _, err = io.Copy(videoDst, video)
-			// if err != nil {
-			// 	http.Error(w, err.Error(), http.StatusInternalServerError)
-			// 	return
-			// }
-
-			p := s.db.Product.
-				Create().
-				SetName(r.FormValue("name")).
-				SetDescription(r.FormValue("description")).
-				SetPrice(price).
-				SetStock(stock)
-			if image != "" {
-				p.SetImage(image)
-			}
-			if video != "" {
-				p.SetVideo(video)
+			image, variants, video, err := s.saveUploads(r)
+			if err != nil {
+				uploadError(w, err)
+				return
 			}
 
-			if _, err = p.Save(r.Context()); err != nil {
+			ctx := actorContext(r)
+			err = stock.WithTx(ctx, s.db, func(tx *ent.Tx) error {
+				p := tx.Product.
+					Create().
+					SetName(r.FormValue("name")).
+					SetDescription(r.FormValue("description")).
+					SetPrice(price).
+					SetStock(quantity)
+				if image != "" {
+					p.SetImage(image).SetImageVariants(variants)
+				}
+				if video != "" {
+					p.SetVideo(video)
+				}
+
+				created, err := p.Save(ctx)
+				if err != nil {
+					return err
+				}
+				return stock.Record(ctx, tx, created.ID, created.Stock, created.Stock, stockmovement.ReasonInitial)
+			})
+			if err != nil {
+				s.removeMedia(r, append(variantURLs(variants), image, video)...)
 				if ent.IsConstraintError(err) {
 					JSON(w, http.StatusConflict, nil, "product with the same name exist")
 					return
 				}
+				s.log(r).Errorf("failed to create product: %v", err)
 				JSON(w, http.StatusInternalServerError, nil, "failed to create product")
 				return
 			}
@@ -224,23 +374,21 @@ func (s Server) SetupRoutes() {
 					return
 				}
 
-				pr := productResponse{
-					ID:          p.ID,
-					Name:        p.Name,
-					Description: p.Description,
-					Price:       p.Price,
-					Stock:       p.Stock,
-					Image:       p.Image,
-					Video:       p.Video,
+				item, err := catalog.Get(r.Context(), s.db, p.ID)
+				if err != nil {
+					s.log(r).Errorf("failed to get product: %v", err)
+					JSON(w, http.StatusInternalServerError, nil, "failed to get product")
+					return
 				}
 
-				JSON(w, http.StatusOK, pr, "Product fetched")
+				JSON(w, http.StatusOK, newProductResponse(item), "Product fetched")
 			})
 
 			r.Group(func(r chi.Router) {
-				r.Use(IsAuthorized, IsAdmin)
+				r.Use(s.IsAuthorized)
 
-				r.Put("/{id}", func(w http.ResponseWriter, r *http.Request) {
+				r.With(s.Require(auth.ProductUpdate)).Put("/{id}", func(w http.ResponseWriter, r *http.Request) {
+					r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize)
 					if err := r.ParseMultipartForm(5 << 20); err != nil {
 						JSON(w, http.StatusBadRequest, nil, "failed to parse multipart form")
 						return
@@ -252,56 +400,352 @@ func (s Server) SetupRoutes() {
 						return
 					}
 
-					stock, err := strconv.Atoi(r.FormValue("stock"))
+					quantity, err := strconv.Atoi(r.FormValue("stock"))
 					if err != nil {
 						JSON(w, http.StatusBadRequest, nil, "failed to parse stock value")
 						return
 					}
 
-					var image string
-					var video string
-
 					p, ok := r.Context().Value("product").(*ent.Product)
 					if !ok {
 						JSON(w, http.StatusInternalServerError, nil, "failed to parse product")
 						return
 					}
-
-					upd := p.
-						Update().
-						SetName(r.FormValue("name")).
-						SetDescription(r.FormValue("description")).
-						SetPrice(price).
-						SetStock(stock)
-					if image != "" {
-						upd.SetImage(image)
+					if quantity != p.Stock && !s.allowed(r, auth.StockAdjust) {
+						JSON(w, http.StatusForbidden, nil, "not allowed to change stock")
+						return
 					}
-					if video != "" {
-						upd.SetVideo(video)
+
+					image, variants, video, err := s.saveUploads(r)
+					if err != nil {
+						uploadError(w, err)
+						return
 					}
 
-					if _, err = upd.Save(r.Context()); err != nil {
+					// Files replaced by the update are removed once it is
+					// committed.
+					var replaced []string
+
+					ctx := actorContext(r)
+					err = stock.WithTx(ctx, s.db, func(tx *ent.Tx) error {
+						// Lock the row so the ledger entry matches the stock
+						// the update replaces.
+						current, err := tx.Product.
+							Query().
+							Where(product.ID(p.ID)).
+							ForUpdate().
+							Only(ctx)
+						if err != nil {
+							return err
+						}
+						if quantity < current.Stock {
+							// Reserved units are promised to orders and must
+							// stay in stock, as with stock.Adjust.
+							reserved, err := stock.Reserved(ctx, tx.Reservation, p.ID)
+							if err != nil {
+								return err
+							}
+							if quantity < reserved[p.ID] {
+								return stock.ErrInsufficientStock
+							}
+						}
+
+						upd := tx.Product.
+							UpdateOne(current).
+							SetName(r.FormValue("name")).
+							SetDescription(r.FormValue("description")).
+							SetPrice(price).
+							SetStock(quantity).
+							SetUpdatedAt(time.Now())
+						if image != "" {
+							upd.SetImage(image).SetImageVariants(variants)
+							replaced = append(replaced, current.Image)
+							replaced = append(replaced, variantURLs(current.ImageVariants)...)
+						}
+						if video != "" {
+							upd.SetVideo(video)
+							replaced = append(replaced, current.Video)
+						}
+
+						if _, err := upd.Save(ctx); err != nil {
+							return err
+						}
+						if delta := quantity - current.Stock; delta != 0 {
+							return stock.Record(ctx, tx, p.ID, delta, quantity, stockmovement.ReasonAdjustment)
+						}
+						return nil
+					})
+					if err != nil {
+						s.removeMedia(r, append(variantURLs(variants), image, video)...)
+						switch {
+						case ent.IsConstraintError(err):
+							JSON(w, http.StatusConflict, nil, "product with the same name exist")
+							return
+						case errors.Is(err, stock.ErrInsufficientStock):
+							JSON(w, http.StatusConflict, nil, "stock cannot be set below the reserved quantity")
+							return
+						}
+						s.log(r).Errorf("failed to update product: %v", err)
 						JSON(w, http.StatusInternalServerError, nil, "failed to update product")
 						return
 					}
+					s.removeMedia(r, replaced...)
 
 					JSON(w, http.StatusNoContent, nil, "product updated")
 				})
 
-				r.Delete("/{id}", func(w http.ResponseWriter, r *http.Request) {
+				r.With(s.Require(auth.StockRead)).Get("/{id}/stock", func(w http.ResponseWriter, r *http.Request) {
 					p, ok := r.Context().Value("product").(*ent.Product)
 					if !ok {
 						JSON(w, http.StatusInternalServerError, nil, "failed to parse product")
 						return
 					}
 
-					err := s.db.Product.
+					movements, total, err := stock.Ledger(r.Context(), s.db, p.ID)
+					if err != nil {
+						s.log(r).Errorf("failed to get stock ledger: %v", err)
+						JSON(w, http.StatusInternalServerError, nil, "failed to get stock ledger")
+						return
+					}
+
+					res := stockLedgerResponse{
+						Stock:      p.Stock,
+						Ledger:     total,
+						Reconciled: total == p.Stock,
+						Movements:  []stockMovementResponse{},
+					}
+					for _, m := range movements {
+						res.Movements = append(res.Movements, stockMovementResponse{
+							ID:          m.ID,
+							Delta:       m.Delta,
+							StockAfter:  m.StockAfter,
+							Reason:      string(m.Reason),
+							Actor:       m.Actor,
+							ReferenceID: m.ReferenceID,
+							CreatedAt:   m.CreatedAt,
+						})
+					}
+
+					JSON(w, http.StatusOK, res, "Stock ledger fetched")
+				})
+
+				// Restocks and corrections share this route, so it needs the
+				// broader adjust permission.
+				r.With(s.Require(auth.StockAdjust)).Post("/{id}/stock", func(w http.ResponseWriter, r *http.Request) {
+					p, ok := r.Context().Value("product").(*ent.Product)
+					if !ok {
+						JSON(w, http.StatusInternalServerError, nil, "failed to parse product")
+						return
+					}
+
+					var req stockChangeRequest
+					if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+						JSON(w, http.StatusBadRequest, nil, "failed to parse request body")
+						return
+					}
+
+					reason := stockmovement.Reason(req.Reason)
+					ctx := stock.WithReference(actorContext(r), req.ReferenceID)
+					var remaining int
+					var err error
+					if reason == stockmovement.ReasonAdjustment {
+						remaining, err = stock.Adjust(ctx, s.db, p.ID, req.Delta)
+					} else {
+						remaining, err = stock.Increase(ctx, s.db, p.ID, req.Delta, reason)
+					}
+					if err != nil {
+						switch {
+						case errors.Is(err, stock.ErrNotFound):
+							JSON(w, http.StatusNotFound, nil, "product not found")
+						case errors.Is(err, stock.ErrInvalidAmount),
+							errors.Is(err, stock.ErrInvalidReason),
+							errors.Is(err, stock.ErrInsufficientStock):
+							JSON(w, http.StatusBadRequest, nil, err.Error())
+						default:
+							s.log(r).Errorf("failed to change stock: %v", err)
+							JSON(w, http.StatusInternalServerError, nil, "failed to change stock")
+						}
+						return
+					}
+
+					JSON(w, http.StatusOK, stockResponse{Stock: remaining}, "stock changed")
+				})
+
+				r.With(s.Require(auth.ProductUpdate)).Post("/{id}/media", func(w http.ResponseWriter, r *http.Request) {
+					p, ok := r.Context().Value("product").(*ent.Product)
+					if !ok {
+						JSON(w, http.StatusInternalServerError, nil, "failed to parse product")
+						return
+					}
+
+					r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize)
+					if err := r.ParseMultipartForm(5 << 20); err != nil {
+						JSON(w, http.StatusBadRequest, nil, "failed to parse multipart form")
+						return
+					}
+
+					var primary bool
+					if v := r.FormValue("is_primary"); v != "" {
+						var err error
+						if primary, err = strconv.ParseBool(v); err != nil {
+							JSON(w, http.StatusBadRequest, nil, "failed to parse is_primary value")
+							return
+						}
+					}
+
+					file, kind, name, err := openUpload(r, "file", imageMedia, videoMedia)
+					if err != nil {
+						uploadError(w, err)
+						return
+					}
+					if file == nil {
+						JSON(w, http.StatusBadRequest, nil, "file cannot be empty")
+						return
+					}
+					defer file.Close()
+
+					m := catalog.NewMedia{
+						AltText: r.FormValue("alt_text"),
+						Primary: primary,
+					}
+					if kind.name == imageMedia.name {
+						m.Kind = productmedia.KindImage
+						m.URL, m.Variants, err = s.storeImage(r, file, name)
+					} else {
+						m.Kind = productmedia.KindVideo
+						m.URL, err = s.storage.Save(r.Context(), name, file)
+					}
+					if err != nil {
+						uploadError(w, err)
+						return
+					}
+
+					created, err := catalog.AddMedia(r.Context(), s.db, p.ID, m)
+					if err != nil {
+						s.removeMedia(r, append(variantURLs(m.Variants), m.URL)...)
+						s.log(r).Errorf("failed to add media: %v", err)
+						JSON(w, http.StatusInternalServerError, nil, "failed to add media")
+						return
+					}
+
+					JSON(w, http.StatusCreated, newMediaResponse(created), "media added")
+				})
+
+				r.With(s.Require(auth.ProductUpdate)).Put("/{id}/media/order", func(w http.ResponseWriter, r *http.Request) {
+					p, ok := r.Context().Value("product").(*ent.Product)
+					if !ok {
+						JSON(w, http.StatusInternalServerError, nil, "failed to parse product")
+						return
+					}
+
+					var req mediaOrderRequest
+					if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+						JSON(w, http.StatusBadRequest, nil, "failed to parse request body")
+						return
+					}
+
+					if err := catalog.ReorderMedia(r.Context(), s.db, p.ID, req.IDs); err != nil {
+						if errors.Is(err, catalog.ErrInvalidOrder) {
+							JSON(w, http.StatusBadRequest, nil, err.Error())
+							return
+						}
+						s.log(r).Errorf("failed to reorder media: %v", err)
+						JSON(w, http.StatusInternalServerError, nil, "failed to reorder media")
+						return
+					}
+
+					JSON(w, http.StatusNoContent, nil, "media reordered")
+				})
+
+				r.With(s.Require(auth.ProductUpdate)).Patch("/{id}/media/{mediaID}", func(w http.ResponseWriter, r *http.Request) {
+					p, ok := r.Context().Value("product").(*ent.Product)
+					if !ok {
+						JSON(w, http.StatusInternalServerError, nil, "failed to parse product")
+						return
+					}
+
+					mediaID, err := strconv.Atoi(chi.URLParam(r, "mediaID"))
+					if err != nil {
+						JSON(w, http.StatusBadRequest, nil, "invalid media id")
+						return
+					}
+
+					var req mediaChangeRequest
+					if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+						JSON(w, http.StatusBadRequest, nil, "failed to parse request body")
+						return
+					}
+
+					m, err := catalog.UpdateMedia(r.Context(), s.db, p.ID, mediaID, catalog.MediaChange{
+						AltText: req.AltText,
+						Primary: req.IsPrimary,
+					})
+					if err != nil {
+						if errors.Is(err, catalog.ErrMediaNotFound) {
+							JSON(w, http.StatusNotFound, nil, "media not found")
+							return
+						}
+						s.log(r).Errorf("failed to update media: %v", err)
+						JSON(w, http.StatusInternalServerError, nil, "failed to update media")
+						return
+					}
+
+					JSON(w, http.StatusOK, newMediaResponse(m), "media updated")
+				})
+
+				r.With(s.Require(auth.ProductUpdate)).Delete("/{id}/media/{mediaID}", func(w http.ResponseWriter, r *http.Request) {
+					p, ok := r.Context().Value("product").(*ent.Product)
+					if !ok {
+						JSON(w, http.StatusInternalServerError, nil, "failed to parse product")
+						return
+					}
+
+					mediaID, err := strconv.Atoi(chi.URLParam(r, "mediaID"))
+					if err != nil {
+						JSON(w, http.StatusBadRequest, nil, "invalid media id")
+						return
+					}
+
+					m, err := catalog.DeleteMedia(r.Context(), s.db, p.ID, mediaID)
+					if err != nil {
+						if errors.Is(err, catalog.ErrMediaNotFound) {
+							JSON(w, http.StatusNotFound, nil, "media not found")
+							return
+						}
+						s.log(r).Errorf("failed to delete media: %v", err)
+						JSON(w, http.StatusInternalServerError, nil, "failed to delete media")
+						return
+					}
+					s.removeMedia(r, append(variantURLs(m.Variants), m.URL)...)
+
+					JSON(w, http.StatusNoContent, nil, "media deleted")
+				})
+
+				r.With(s.Require(auth.ProductDelete)).Delete("/{id}", func(w http.ResponseWriter, r *http.Request) {
+					p, ok := r.Context().Value("product").(*ent.Product)
+					if !ok {
+						JSON(w, http.StatusInternalServerError, nil, "failed to parse product")
+						return
+					}
+
+					gallery, err := catalog.Gallery(r.Context(), s.db, p.ID)
+					if err != nil {
+						s.log(r).Errorf("failed to delete product: %v", err)
+						JSON(w, http.StatusInternalServerError, nil, "failed to delete product")
+						return
+					}
+
+					err = s.db.Product.
 						DeleteOne(p).
 						Exec(r.Context())
 					if err != nil {
 						JSON(w, http.StatusBadRequest, nil, "failed to delete product")
 						return
 					}
+					s.removeMedia(r, append(variantURLs(p.ImageVariants), p.Image, p.Video)...)
+					for _, m := range gallery {
+						s.removeMedia(r, append(variantURLs(m.Variants), m.URL)...)
+					}
 
 					JSON(w, http.StatusNoContent, nil, "Product deleted")
 				})
@@ -310,11 +754,76 @@ func (s Server) SetupRoutes() {
 	})
 }
 
-func (s Server) Start() error {
-	if err := http.ListenAndServe(":"+os.Getenv("PORT"), s.router); err != nil {
-		return err
+// listParams parses the pagination, sorting and filtering query parameters of
+// GET /products.
+func listParams(q url.Values) (catalog.ListParams, error) {
+	params := catalog.ListParams{
+		Limit:      catalog.DefaultPageSize,
+		Cursor:     q.Get("cursor"),
+		Sort:       q.Get("sort"),
+		NamePrefix: q.Get("name_prefix"),
 	}
-	return nil
+
+	if v := q.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 1 || limit > catalog.MaxPageSize {
+			return params, fmt.Errorf("limit must be between 1 and %d", catalog.MaxPageSize)
+		}
+		params.Limit = limit
+	}
+	if v := q.Get("min_price"); v != "" {
+		price, err := strconv.Atoi(v)
+		if err != nil {
+			return params, errors.New("failed to parse min_price value")
+		}
+		params.MinPrice = &price
+	}
+	if v := q.Get("max_price"); v != "" {
+		price, err := strconv.Atoi(v)
+		if err != nil {
+			return params, errors.New("failed to parse max_price value")
+		}
+		params.MaxPrice = &price
+	}
+	if v := q.Get("in_stock"); v != "" {
+		inStock, err := strconv.ParseBool(v)
+		if err != nil {
+			return params, errors.New("failed to parse in_stock value")
+		}
+		params.InStock = inStock
+	}
+	return params, nil
+}
+
+// actorContext returns the request context carrying the authorized user as
+// the actor of stock changes.
+func actorContext(r *http.Request) context.Context {
+	if u, ok := r.Context().Value("user").(auth.User); ok {
+		return stock.WithActor(r.Context(), u.Username)
+	}
+	return r.Context()
+}
+
+// Start serves HTTP, or HTTPS when tlsConfig is not nil, until Shutdown is
+// called.
+func (s Server) Start(cfg config.HTTP, tlsConfig *tls.Config) error {
+	s.httpServer.Addr = fmt.Sprintf(":%d", cfg.Port)
+	s.httpServer.Handler = s.router
+	s.httpServer.TLSConfig = tlsConfig
+	s.httpServer.ReadHeaderTimeout = cfg.ReadHeaderTimeout
+	s.httpServer.ReadTimeout = cfg.ReadTimeout
+	s.httpServer.WriteTimeout = cfg.WriteTimeout
+	s.httpServer.IdleTimeout = cfg.IdleTimeout
+	if tlsConfig != nil {
+		return s.httpServer.ListenAndServeTLS("", "")
+	}
+	return s.httpServer.ListenAndServe()
+}
+
+// Shutdown stops accepting requests and waits for in-flight ones to finish
+// until ctx is done.
+func (s Server) Shutdown(ctx context.Context) error {
+	return s.httpServer.Shutdown(ctx)
 }
 
 func JSON(w http.ResponseWriter, status int, v any, message string) error {
@@ -331,18 +840,15 @@ func JSON(w http.ResponseWriter, status int, v any, message string) error {
 		}
 	}
 
-	logType := "INFO"
-	if status >= 300 {
-		logType = "ERROR"
+	if logShipper != nil {
+		logType := "INFO"
+		if status >= 300 {
+			logType = "ERROR"
+		}
+		logShipper.Enqueue(logship.Entry{
+			Type:    logType,
+			Message: strconv.Itoa(status) + " - " + message,
+		})
 	}
-	marshall, _ := json.Marshal(map[string]string{
-		"type":    logType,
-		"service": "products",
-		"message": strconv.Itoa(status) + " - " + message,
-	})
-	req, _ := http.NewRequest("POST", os.Getenv("LOG_SERVICE_URL"), bytes.NewReader(marshall))
-	req.Header.Add("Content-Type", "application/json")
-	_, _ = http.DefaultClient.Do(req)
-
 	return nil
 }
@@ -0,0 +1,132 @@
+package stock
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/law-a-1/product-service/ent"
+	"github.com/law-a-1/product-service/ent/stockmovement"
+)
+
+var ErrInvalidReason = errors.New("reason is not valid for this change")
+
+type actorKey struct{}
+
+// WithActor returns a copy of ctx recording actor as the one responsible for
+// the stock changes made with it.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+func actorFrom(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey{}).(string)
+	return actor
+}
+
+type referenceKey struct{}
+
+// WithReference returns a copy of ctx recording reference, such as an order
+// or delivery ID, on the stock changes made with it.
+func WithReference(ctx context.Context, reference string) context.Context {
+	return context.WithValue(ctx, referenceKey{}, reference)
+}
+
+func referenceFrom(ctx context.Context) string {
+	reference, _ := ctx.Value(referenceKey{}).(string)
+	return reference
+}
+
+// Record writes a ledger entry for a change of delta units that left the
+// product with stockAfter units. Every change of a product's stock goes
+// through here, so the stock can always be reconciled from the ledger.
+func Record(ctx context.Context, tx *ent.Tx, productID, delta, stockAfter int, reason stockmovement.Reason) error {
+	m := tx.StockMovement.
+		Create().
+		SetProductID(productID).
+		SetDelta(delta).
+		SetStockAfter(stockAfter).
+		SetReason(reason)
+	if actor := actorFrom(ctx); actor != "" {
+		m.SetActor(actor)
+	}
+	if reference := referenceFrom(ctx); reference != "" {
+		m.SetReferenceID(reference)
+	}
+	return m.Exec(ctx)
+}
+
+// Increase puts amount units back into the stock of the product with the given
+// ID and returns the new stock. Only restocks, returns and cancellations can
+// increase stock.
+func Increase(ctx context.Context, client *ent.Client, id, amount int, reason stockmovement.Reason) (int, error) {
+	if amount <= 0 {
+		return 0, ErrInvalidAmount
+	}
+	switch reason {
+	case stockmovement.ReasonRestock, stockmovement.ReasonReturn, stockmovement.ReasonCancellation:
+	default:
+		return 0, ErrInvalidReason
+	}
+	return change(ctx, client, id, amount, reason)
+}
+
+// Adjust corrects the stock of the product with the given ID by delta, for
+// example after a stock count, and returns the new stock. The stock cannot be
+// adjusted below the quantity held by active reservations.
+func Adjust(ctx context.Context, client *ent.Client, id, delta int) (int, error) {
+	if delta == 0 {
+		return 0, ErrInvalidAmount
+	}
+	return change(ctx, client, id, delta, stockmovement.ReasonAdjustment)
+}
+
+func change(ctx context.Context, client *ent.Client, id, delta int, reason stockmovement.Reason) (int, error) {
+	var remaining int
+	err := WithTx(ctx, client, func(tx *ent.Tx) error {
+		p, err := lockProduct(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if delta < 0 {
+			// Reserved units are promised to orders and must stay in stock.
+			reserved, err := Reserved(ctx, tx.Reservation, id)
+			if err != nil {
+				return err
+			}
+			if p.Stock+delta < reserved[id] {
+				return ErrInsufficientStock
+			}
+		}
+
+		p, err = p.Update().
+			AddStock(delta).
+			SetUpdatedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		remaining = p.Stock
+		return Record(ctx, tx, id, delta, p.Stock, reason)
+	})
+	return remaining, err
+}
+
+// Ledger returns the movements of the product with the given ID, oldest first,
+// together with the stock they add up to.
+func Ledger(ctx context.Context, client *ent.Client, id int) ([]*ent.StockMovement, int, error) {
+	movements, err := client.StockMovement.
+		Query().
+		Where(stockmovement.ProductID(id)).
+		Order(ent.Asc(stockmovement.FieldCreatedAt), ent.Asc(stockmovement.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var total int
+	for _, m := range movements {
+		total += m.Delta
+	}
+	return movements, total, nil
+}
@@ -0,0 +1,236 @@
+package stock
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/law-a-1/product-service/ent"
+	"github.com/law-a-1/product-service/ent/product"
+	"github.com/law-a-1/product-service/ent/reservation"
+	"github.com/law-a-1/product-service/ent/stockmovement"
+	"go.uber.org/zap"
+)
+
+const (
+	DefaultReservationTTL = 15 * time.Minute
+	MaxReservationTTL     = 24 * time.Hour
+)
+
+var (
+	ErrReservationNotFound  = errors.New("reservation with given ID not found")
+	ErrReservationNotActive = errors.New("reservation is no longer active")
+	ErrInvalidOwner         = errors.New("owner cannot be empty")
+	ErrInvalidTTL           = errors.New("ttl exceeds the maximum reservation time")
+)
+
+// Reserved returns the quantity held by active, unexpired reservations for
+// each of the given product IDs. Products without reservations are omitted.
+func Reserved(ctx context.Context, c *ent.ReservationClient, ids ...int) (map[int]int, error) {
+	var rows []struct {
+		ProductID int `json:"product_id"`
+		Sum       int `json:"sum"`
+	}
+	err := c.Query().
+		Where(
+			reservation.ProductIDIn(ids...),
+			reservation.StatusEQ(reservation.StatusActive),
+			reservation.ExpiresAtGT(time.Now()),
+		).
+		GroupBy(reservation.FieldProductID).
+		Aggregate(ent.Sum(reservation.FieldQuantity)).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	reserved := make(map[int]int, len(rows))
+	for _, r := range rows {
+		reserved[r.ProductID] = r.Sum
+	}
+	return reserved, nil
+}
+
+// Available returns stock minus active reservations for each of the given
+// product IDs. Unknown IDs are omitted.
+func Available(ctx context.Context, client *ent.Client, ids ...int) (map[int]int, error) {
+	products, err := client.Product.
+		Query().
+		Where(product.IDIn(ids...)).
+		Select(product.FieldID, product.FieldStock).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reserved, err := Reserved(ctx, client.Reservation, ids...)
+	if err != nil {
+		return nil, err
+	}
+
+	available := make(map[int]int, len(products))
+	for _, p := range products {
+		available[p.ID] = p.Stock - reserved[p.ID]
+	}
+	return available, nil
+}
+
+// Reserve holds amount units of the product with the given ID for owner until
+// the ttl passes. A zero ttl uses DefaultReservationTTL.
+func Reserve(ctx context.Context, client *ent.Client, id, amount int, owner string, ttl time.Duration) (*ent.Reservation, error) {
+	if amount <= 0 {
+		return nil, ErrInvalidAmount
+	}
+	if owner == "" {
+		return nil, ErrInvalidOwner
+	}
+	if ttl <= 0 {
+		ttl = DefaultReservationTTL
+	}
+	if ttl > MaxReservationTTL {
+		return nil, ErrInvalidTTL
+	}
+
+	var r *ent.Reservation
+	err := WithTx(ctx, client, func(tx *ent.Tx) error {
+		p, err := lockProduct(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		reserved, err := Reserved(ctx, tx.Reservation, id)
+		if err != nil {
+			return err
+		}
+		if p.Stock-reserved[id] < amount {
+			return ErrInsufficientStock
+		}
+
+		r, err = tx.Reservation.
+			Create().
+			SetProductID(id).
+			SetQuantity(amount).
+			SetOwner(owner).
+			SetExpiresAt(time.Now().Add(ttl)).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Confirm turns an active reservation into a sale, taking its quantity out of
+// the product stock, and returns the remaining stock.
+func Confirm(ctx context.Context, client *ent.Client, id int) (int, error) {
+	var remaining int
+	err := WithTx(ctx, client, func(tx *ent.Tx) error {
+		r, err := lockReservation(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if r.Status != reservation.StatusActive || !r.ExpiresAt.After(time.Now()) {
+			return ErrReservationNotActive
+		}
+
+		p, err := lockProduct(ctx, tx, r.ProductID)
+		if err != nil {
+			return err
+		}
+		if p.Stock < r.Quantity {
+			return ErrInsufficientStock
+		}
+		p, err = p.Update().
+			AddStock(-r.Quantity).
+			SetUpdatedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		remaining = p.Stock
+		if referenceFrom(ctx) == "" {
+			ctx = WithReference(ctx, r.Owner)
+		}
+		if err := Record(ctx, tx, p.ID, -r.Quantity, p.Stock, stockmovement.ReasonSale); err != nil {
+			return err
+		}
+
+		return r.Update().
+			SetStatus(reservation.StatusConfirmed).
+			SetUpdatedAt(time.Now()).
+			Exec(ctx)
+	})
+	return remaining, err
+}
+
+// Cancel releases an active reservation. Cancelling an already cancelled or
+// expired reservation is a no-op.
+func Cancel(ctx context.Context, client *ent.Client, id int) error {
+	return WithTx(ctx, client, func(tx *ent.Tx) error {
+		r, err := lockReservation(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		switch r.Status {
+		case reservation.StatusCancelled, reservation.StatusExpired:
+			return nil
+		case reservation.StatusConfirmed:
+			return ErrReservationNotActive
+		}
+
+		return r.Update().
+			SetStatus(reservation.StatusCancelled).
+			SetUpdatedAt(time.Now()).
+			Exec(ctx)
+	})
+}
+
+func lockReservation(ctx context.Context, tx *ent.Tx, id int) (*ent.Reservation, error) {
+	r, err := tx.Reservation.
+		Query().
+		Where(reservation.ID(id)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrReservationNotFound
+		}
+		return nil, err
+	}
+	return r, nil
+}
+
+// ReleaseExpired marks active reservations past their expiry as expired and
+// returns how many were released.
+func ReleaseExpired(ctx context.Context, client *ent.Client) (int, error) {
+	return client.Reservation.
+		Update().
+		Where(
+			reservation.StatusEQ(reservation.StatusActive),
+			reservation.ExpiresAtLTE(time.Now()),
+		).
+		SetStatus(reservation.StatusExpired).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+}
+
+// RunSweeper releases expired reservations every interval until ctx is done.
+func RunSweeper(ctx context.Context, client *ent.Client, logger *zap.SugaredLogger, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := ReleaseExpired(ctx, client)
+			if err != nil {
+				logger.Warnf("failed to release expired reservations: %v", err)
+				continue
+			}
+			if n > 0 {
+				logger.Infof("released %d expired reservations", n)
+			}
+		}
+	}
+}
@@ -0,0 +1,184 @@
+// Package stock implements the stock mutations shared by the HTTP and gRPC servers.
+package stock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/law-a-1/product-service/ent"
+	"github.com/law-a-1/product-service/ent/product"
+	"github.com/law-a-1/product-service/ent/stockmovement"
+)
+
+var (
+	ErrNotFound          = errors.New("product with given ID not found")
+	ErrInsufficientStock = errors.New("stock is not enough")
+	ErrInvalidAmount     = errors.New("amount must be greater than zero")
+)
+
+// WithTx runs fn inside a transaction, committing it when fn succeeds and
+// rolling it back otherwise.
+func WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
+
+// Decrease takes amount units out of the stock of the product with the given
+// ID and returns the remaining stock. Units held by active reservations cannot
+// be taken, and the product row is locked for the check so concurrent callers
+// can never oversell.
+func Decrease(ctx context.Context, client *ent.Client, id, amount int) (int, error) {
+	var remaining int
+	err := WithTx(ctx, client, func(tx *ent.Tx) error {
+		var err error
+		remaining, err = decrease(ctx, tx, id, amount)
+		return err
+	})
+	return remaining, err
+}
+
+func decrease(ctx context.Context, tx *ent.Tx, id, amount int) (int, error) {
+	if amount <= 0 {
+		return 0, ErrInvalidAmount
+	}
+
+	p, err := lockProduct(ctx, tx, id)
+	if err != nil {
+		return 0, err
+	}
+	reserved, err := Reserved(ctx, tx.Reservation, id)
+	if err != nil {
+		return 0, err
+	}
+	if p.Stock-reserved[id] < amount {
+		return 0, ErrInsufficientStock
+	}
+
+	p, err = p.Update().
+		AddStock(-amount).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if err := Record(ctx, tx, id, -amount, p.Stock, stockmovement.ReasonSale); err != nil {
+		return 0, err
+	}
+	return p.Stock, nil
+}
+
+// lockProduct loads the product with the given ID and locks its row until the
+// transaction ends, serialising every stock change of that product.
+func lockProduct(ctx context.Context, tx *ent.Tx, id int) (*ent.Product, error) {
+	p, err := tx.Product.
+		Query().
+		Where(product.ID(id)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return p, nil
+}
+
+// Line is a single product/amount pair of a batch decrease.
+type Line struct {
+	ID     int
+	Amount int
+}
+
+// Failure describes why a line of a batch decrease was rejected. Err is one of
+// ErrNotFound, ErrInsufficientStock or ErrInvalidAmount.
+type Failure struct {
+	ID        int
+	Err       error
+	Requested int
+	Available int
+}
+
+// BatchError is returned by DecreaseBatch when at least one line is rejected.
+type BatchError struct {
+	Failures []Failure
+}
+
+func (e *BatchError) Error() string {
+	return fmt.Sprintf("stock decrease rejected for %d line(s)", len(e.Failures))
+}
+
+// DecreaseBatch applies all lines in a single transaction and returns the
+// remaining stock per product ID. Lines for the same product are merged. When
+// any line is rejected nothing is applied and a *BatchError listing every
+// rejected line is returned.
+func DecreaseBatch(ctx context.Context, client *ent.Client, lines []Line) (map[int]int, error) {
+	merged := make(map[int]int, len(lines))
+	var ids []int
+	var failures []Failure
+	for _, l := range lines {
+		if l.Amount <= 0 {
+			failures = append(failures, Failure{ID: l.ID, Err: ErrInvalidAmount, Requested: l.Amount})
+			continue
+		}
+		if _, ok := merged[l.ID]; !ok {
+			ids = append(ids, l.ID)
+		}
+		merged[l.ID] += l.Amount
+	}
+	if len(failures) > 0 {
+		return nil, &BatchError{Failures: failures}
+	}
+	// Locking rows in a fixed order keeps concurrent batches from deadlocking.
+	sort.Ints(ids)
+
+	remaining := make(map[int]int, len(ids))
+	err := WithTx(ctx, client, func(tx *ent.Tx) error {
+		for _, id := range ids {
+			left, err := decrease(ctx, tx, id, merged[id])
+			switch {
+			case err == nil:
+				remaining[id] = left
+			case errors.Is(err, ErrNotFound):
+				failures = append(failures, Failure{ID: id, Err: err, Requested: merged[id]})
+			case errors.Is(err, ErrInsufficientStock):
+				available, err := Available(ctx, tx.Client(), id)
+				if err != nil {
+					return err
+				}
+				failures = append(failures, Failure{ID: id, Err: ErrInsufficientStock, Requested: merged[id], Available: available[id]})
+			default:
+				return err
+			}
+		}
+		if len(failures) > 0 {
+			return &BatchError{Failures: failures}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return remaining, nil
+}
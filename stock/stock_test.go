@@ -0,0 +1,178 @@
+package stock_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	_ "github.com/jackc/pgx/v4/stdlib"
+	"github.com/law-a-1/product-service/ent"
+	"github.com/law-a-1/product-service/ent/enttest"
+	"github.com/law-a-1/product-service/ent/stockmovement"
+	"github.com/law-a-1/product-service/stock"
+)
+
+// openClient returns a client of the Postgres database at TEST_DATABASE_URL,
+// migrated to the ent schema. Tests needing it are skipped when it is unset,
+// since row locks cannot be exercised without Postgres.
+func openClient(t *testing.T) *ent.Client {
+	t.Helper()
+	url := os.Getenv("TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+	db, err := sql.Open("pgx", url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.SetMaxOpenConns(32)
+	client := enttest.NewClient(t, enttest.WithOptions(ent.Driver(entsql.OpenDB(dialect.Postgres, db))))
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func createProduct(t *testing.T, client *ent.Client, stock int) *ent.Product {
+	t.Helper()
+	ctx := context.Background()
+	p, err := client.Product.
+		Create().
+		SetName(fmt.Sprintf("%s-%d", t.Name(), time.Now().UnixNano())).
+		SetDescription("test product").
+		SetPrice(1000).
+		SetStock(stock).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		client.Product.DeleteOneID(p.ID).Exec(context.Background())
+	})
+	return p
+}
+
+// assertLedger checks that no movement of the product left it with negative
+// stock and that the stock ends at want.
+func assertLedger(t *testing.T, client *ent.Client, id, want int) {
+	t.Helper()
+	ctx := context.Background()
+	movements, err := client.StockMovement.Query().Where(stockmovement.ProductID(id)).All(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range movements {
+		if m.StockAfter < 0 {
+			t.Errorf("movement %d left the stock at %d", m.ID, m.StockAfter)
+		}
+	}
+	p, err := client.Product.Get(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Stock != want {
+		t.Errorf("stock = %d, want %d", p.Stock, want)
+	}
+}
+
+func TestDecreaseConcurrent(t *testing.T) {
+	client := openClient(t)
+	const initial, callers = 5, 25
+	p := createProduct(t, client, initial)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := stock.Decrease(context.Background(), client, p.ID, 1)
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	var succeeded int
+	for err := range errs {
+		switch {
+		case err == nil:
+			succeeded++
+		case !errors.Is(err, stock.ErrInsufficientStock):
+			t.Errorf("Decrease: %v, want %v", err, stock.ErrInsufficientStock)
+		}
+	}
+	if succeeded != initial {
+		t.Errorf("%d decreases succeeded, want %d", succeeded, initial)
+	}
+	assertLedger(t, client, p.ID, 0)
+}
+
+func TestDecreaseBatchConcurrent(t *testing.T) {
+	client := openClient(t)
+	const callers = 20
+	scarce := createProduct(t, client, 4)
+	plenty := createProduct(t, client, 100)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, callers)
+	for i := 0; i < callers; i++ {
+		// Alternate the line order so that lock ordering is exercised.
+		lines := []stock.Line{{ID: scarce.ID, Amount: 1}, {ID: plenty.ID, Amount: 2}}
+		if i%2 == 1 {
+			lines[0], lines[1] = lines[1], lines[0]
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := stock.DecreaseBatch(context.Background(), client, lines)
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	var succeeded int
+	for err := range errs {
+		var batchErr *stock.BatchError
+		switch {
+		case err == nil:
+			succeeded++
+		case errors.As(err, &batchErr):
+			if len(batchErr.Failures) != 1 || batchErr.Failures[0].ID != scarce.ID ||
+				!errors.Is(batchErr.Failures[0].Err, stock.ErrInsufficientStock) {
+				t.Errorf("DecreaseBatch failures = %+v, want only %d out of stock", batchErr.Failures, scarce.ID)
+			}
+		default:
+			t.Errorf("DecreaseBatch: %v", err)
+		}
+	}
+	if succeeded != 4 {
+		t.Errorf("%d batches succeeded, want 4", succeeded)
+	}
+	// Rejected batches must not have taken anything from either product.
+	assertLedger(t, client, scarce.ID, 0)
+	assertLedger(t, client, plenty.ID, 100-2*4)
+}
+
+func TestAdjustKeepsReservedStock(t *testing.T) {
+	client := openClient(t)
+	ctx := context.Background()
+	p := createProduct(t, client, 5)
+	if _, err := stock.Reserve(ctx, client, p.ID, 3, "order-1", time.Minute); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := stock.Adjust(ctx, client, p.ID, -3); !errors.Is(err, stock.ErrInsufficientStock) {
+		t.Errorf("Adjust(-3): %v, want %v", err, stock.ErrInsufficientStock)
+	}
+	if _, err := stock.Adjust(ctx, client, p.ID, -2); err != nil {
+		t.Errorf("Adjust(-2): %v", err)
+	}
+	assertLedger(t, client, p.ID, 3)
+}
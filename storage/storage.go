@@ -0,0 +1,98 @@
+// Package storage stores the media files uploaded for products.
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Storage stores files under a name and serves them from a URL.
+type Storage interface {
+	// Save stores the content of r under name and returns the URL the file
+	// is served from.
+	Save(ctx context.Context, name string, r io.Reader) (string, error)
+	// Delete removes the file served from url. Deleting a file that does not
+	// exist is not an error.
+	Delete(ctx context.Context, url string) error
+}
+
+var ErrInvalidName = errors.New("invalid file name")
+
+// Local stores files in a directory of the local filesystem, which is served
+// by the reverse proxy under baseURL.
+type Local struct {
+	root    string
+	baseURL string
+}
+
+// NewLocal returns a Local storage writing into root, creating it when needed.
+func NewLocal(root, baseURL string) (*Local, error) {
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, fmt.Errorf("creating media directory: %w", err)
+	}
+	return &Local{
+		root:    root,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+	}, nil
+}
+
+func (l *Local) Save(ctx context.Context, name string, r io.Reader) (string, error) {
+	path, err := l.path(name)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", err
+	}
+
+	// Write to a temporary file first so a failed upload never leaves a
+	// truncated file behind under the final name.
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return "", err
+	}
+	if err := tmp.Close(); err != nil {
+		return "", err
+	}
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return "", err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", err
+	}
+	return l.baseURL + "/" + filepath.ToSlash(name), nil
+}
+
+func (l *Local) Delete(ctx context.Context, url string) error {
+	if !strings.HasPrefix(url, l.baseURL+"/") {
+		return ErrInvalidName
+	}
+	path, err := l.path(strings.TrimPrefix(url, l.baseURL+"/"))
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// path resolves name inside the storage root, refusing names escaping it.
+func (l *Local) path(name string) (string, error) {
+	clean := filepath.Clean(filepath.FromSlash(name))
+	if clean == "." || filepath.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, ".."+string(filepath.Separator)) {
+		return "", ErrInvalidName
+	}
+	return filepath.Join(l.root, clean), nil
+}
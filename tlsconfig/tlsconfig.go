@@ -0,0 +1,156 @@
+// Package tlsconfig builds server TLS configurations whose certificates are
+// reloaded when their files change, so rotated certificates are picked up
+// without a restart.
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// checkInterval bounds how often the files are checked for changes.
+const checkInterval = time.Second
+
+// Files are the PEM files of a server's TLS configuration.
+type Files struct {
+	Cert string
+	Key  string
+	// ClientCA, when set, enables mTLS: client certificates are verified
+	// against the CAs in the file.
+	ClientCA string
+	// RequireClientCert rejects clients without a certificate. Otherwise a
+	// certificate is only verified when the client presents one.
+	RequireClientCert bool
+}
+
+// Reloader serves the certificate and client CAs of its files, reloading them
+// when the files are modified.
+type Reloader struct {
+	files  Files
+	logger *zap.SugaredLogger
+
+	mu        sync.Mutex
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+	modTimes  [3]time.Time
+	checkedAt time.Time
+}
+
+// NewReloader loads files, failing when they are missing or invalid.
+func NewReloader(files Files, logger *zap.SugaredLogger) (*Reloader, error) {
+	if files.Cert == "" || files.Key == "" {
+		return nil, errors.New("tls needs a certificate and a key file")
+	}
+	r := &Reloader{files: files, logger: logger}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Config returns a server configuration negotiating nextProtos.
+func (r *Reloader) Config(nextProtos ...string) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		NextProtos: nextProtos,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			cert, _ := r.current()
+			return cert, nil
+		},
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, clientCAs := r.current()
+			cfg := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				NextProtos:   nextProtos,
+				Certificates: []tls.Certificate{*cert},
+			}
+			if clientCAs != nil {
+				cfg.ClientCAs = clientCAs
+				cfg.ClientAuth = tls.VerifyClientCertIfGiven
+				if r.files.RequireClientCert {
+					cfg.ClientAuth = tls.RequireAndVerifyClientCert
+				}
+			}
+			return cfg, nil
+		},
+	}
+}
+
+// current returns the loaded certificate and client CAs, reloading them first
+// when the files changed. A failed reload keeps serving the previous ones.
+func (r *Reloader) current() (*tls.Certificate, *x509.CertPool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if time.Since(r.checkedAt) >= checkInterval {
+		r.checkedAt = time.Now()
+		if r.changed() {
+			if err := r.load(); err != nil {
+				r.logger.Errorf("failed to reload tls certificates: %v", err)
+			} else {
+				r.logger.Infof("reloaded tls certificate %s", r.files.Cert)
+			}
+		}
+	}
+	return r.cert, r.clientCAs
+}
+
+func (r *Reloader) paths() [3]string {
+	return [3]string{r.files.Cert, r.files.Key, r.files.ClientCA}
+}
+
+func (r *Reloader) changed() bool {
+	for i, path := range r.paths() {
+		if path == "" {
+			continue
+		}
+		info, err := os.Stat(path)
+		if err == nil && !info.ModTime().Equal(r.modTimes[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Reloader) load() error {
+	var modTimes [3]time.Time
+	for i, path := range r.paths() {
+		if path == "" {
+			continue
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		modTimes[i] = info.ModTime()
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.files.Cert, r.files.Key)
+	if err != nil {
+		return fmt.Errorf("loading certificate: %w", err)
+	}
+
+	var clientCAs *x509.CertPool
+	if r.files.ClientCA != "" {
+		pem, err := os.ReadFile(r.files.ClientCA)
+		if err != nil {
+			return fmt.Errorf("reading client ca: %w", err)
+		}
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificates in client ca %s", r.files.ClientCA)
+		}
+	}
+
+	r.cert = &cert
+	r.clientCAs = clientCAs
+	r.modTimes = modTimes
+	return nil
+}
@@ -0,0 +1,181 @@
+package tracing
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Driver wraps an ent driver of Postgres to trace its queries and
+// transactions. The statements are recorded without their arguments.
+func Driver(drv dialect.Driver) dialect.Driver {
+	return &tracedDriver{Driver: drv}
+}
+
+type tracedDriver struct {
+	dialect.Driver
+}
+
+func (d *tracedDriver) Exec(ctx context.Context, query string, args, v interface{}) error {
+	ctx, span := startQuery(ctx, query)
+	err := d.Driver.Exec(ctx, query, args, v)
+	endSpan(span, err)
+	return err
+}
+
+func (d *tracedDriver) Query(ctx context.Context, query string, args, v interface{}) error {
+	ctx, span := startQuery(ctx, query)
+	err := d.Driver.Query(ctx, query, args, v)
+	endSpan(span, err)
+	return err
+}
+
+// ExecContext is used by the ExecContext method of ent clients.
+func (d *tracedDriver) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	ex, ok := d.Driver.(interface {
+		ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	ctx, span := startQuery(ctx, query)
+	res, err := ex.ExecContext(ctx, query, args...)
+	endSpan(span, err)
+	return res, err
+}
+
+// QueryContext is used by the QueryContext method of ent clients. The span
+// ends when the query returns, before its rows are read.
+func (d *tracedDriver) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	q, ok := d.Driver.(interface {
+		QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	ctx, span := startQuery(ctx, query)
+	rows, err := q.QueryContext(ctx, query, args...)
+	endSpan(span, err)
+	return rows, err
+}
+
+func (d *tracedDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	return d.BeginTx(ctx, nil)
+}
+
+// BeginTx starts a transaction whose span lasts until it is committed or
+// rolled back. Statements run in it are traced as its children.
+func (d *tracedDriver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	ctx, span := tracer().Start(ctx, "db.transaction",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.DBSystemPostgreSQL),
+	)
+
+	var tx dialect.Tx
+	var err error
+	if b, ok := d.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	}); ok {
+		tx, err = b.BeginTx(ctx, opts)
+	} else {
+		tx, err = d.Driver.Tx(ctx)
+	}
+	if err != nil {
+		endSpan(span, err)
+		return nil, err
+	}
+	return &tracedTx{Tx: tx, ctx: ctx, span: span}, nil
+}
+
+// tracedTx traces a transaction. Statements are traced under the span of the
+// transaction, whatever context they are run with.
+type tracedTx struct {
+	dialect.Tx
+	ctx  context.Context
+	span trace.Span
+}
+
+func (t *tracedTx) Exec(ctx context.Context, query string, args, v interface{}) error {
+	ctx, span := startQuery(t.parent(ctx), query)
+	err := t.Tx.Exec(ctx, query, args, v)
+	endSpan(span, err)
+	return err
+}
+
+func (t *tracedTx) Query(ctx context.Context, query string, args, v interface{}) error {
+	ctx, span := startQuery(t.parent(ctx), query)
+	err := t.Tx.Query(ctx, query, args, v)
+	endSpan(span, err)
+	return err
+}
+
+func (t *tracedTx) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	ex, ok := t.Tx.(interface {
+		ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	ctx, span := startQuery(t.parent(ctx), query)
+	res, err := ex.ExecContext(ctx, query, args...)
+	endSpan(span, err)
+	return res, err
+}
+
+func (t *tracedTx) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	q, ok := t.Tx.(interface {
+		QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	ctx, span := startQuery(t.parent(ctx), query)
+	rows, err := q.QueryContext(ctx, query, args...)
+	endSpan(span, err)
+	return rows, err
+}
+
+func (t *tracedTx) Commit() error {
+	err := t.Tx.Commit()
+	endSpan(t.span, err)
+	return err
+}
+
+func (t *tracedTx) Rollback() error {
+	err := t.Tx.Rollback()
+	t.span.SetAttributes(attribute.Bool("db.rollback", true))
+	endSpan(t.span, err)
+	return err
+}
+
+// parent returns ctx carrying the span of the transaction.
+func (t *tracedTx) parent(ctx context.Context) context.Context {
+	return trace.ContextWithSpan(ctx, t.span)
+}
+
+func startQuery(ctx context.Context, query string) (context.Context, trace.Span) {
+	op := strings.ToUpper(strings.SplitN(strings.TrimSpace(query), " ", 2)[0])
+	return tracer().Start(ctx, "db."+strings.ToLower(op),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBOperationKey.String(op),
+			semconv.DBStatementKey.String(query),
+		),
+	)
+}
+
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}